@@ -0,0 +1,226 @@
+package huh
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	"github.com/charmbracelet/huh/accessibility"
+	"github.com/charmbracelet/lipgloss"
+	"golang.org/x/term"
+)
+
+// defaultAccessibleWidth is the width accessible output is wrapped to when
+// there is no width and the width of the terminal can't be detected, such as
+// when output is captured.
+const defaultAccessibleWidth = 80
+
+// accessibleWidth returns the width to wrap accessible output to, which is
+// the given width if set or the width of the terminal.
+func accessibleWidth(width int) int {
+	if width > 0 {
+		return width
+	}
+	if w, _, err := term.GetSize(int(os.Stdout.Fd())); err == nil && w > 0 {
+		return w
+	}
+	return defaultAccessibleWidth
+}
+
+// accessibleBlock renders accessible output in the base style, wrapped to
+// the accessible width, with plain text in place of escape sequences, such
+// as the URLs of links, see deferEscape. Lines aren't padded to the width, so
+// that they read well when captured.
+func accessibleBlock(base lipgloss.Style, text string, width int) string {
+	width = max(accessibleWidth(width)-base.GetHorizontalFrameSize(), 1)
+	view := base.Render(lipgloss.NewStyle().Width(width).Render(plainEscapes(text)))
+	lines := strings.Split(view, "\n")
+	for i := range lines {
+		lines[i] = strings.TrimRight(lines[i], " ")
+	}
+	return strings.Join(lines, "\n")
+}
+
+// errBack is returned by accessible prompts when the user answers with the
+// Back answer to return to the previous question of a form.
+var errBack = errors.New("back")
+
+// isBack returns whether the input is the Back answer and going back is
+// allowed.
+func isBack(s *Strings, input string) bool {
+	return s.allowBack && strings.EqualFold(strings.TrimSpace(input), s.Back)
+}
+
+// errorText formats an error for accessible output, marked with the error
+// prefix and preceded by the bell if enabled.
+func (s *Strings) errorText(err error) string {
+	text := s.ErrorPrefix + err.Error()
+	if s.bell {
+		text = "\a" + text
+	}
+	return text
+}
+
+// reprompt formats an error printed before asking again, followed by the
+// question being asked, if known.
+func (s *Strings) reprompt(err error) string {
+	text := s.errorText(err)
+	if s.question != "" {
+		text += "\n" + s.question
+	}
+	return text
+}
+
+// trapInterrupts asks for the confirmation of quitting on interrupts, such as
+// ctrl+c, instead of letting them end the program. The answer is read by the
+// prompt waiting for input, see promptString. It returns a function that stops
+// trapping them.
+func (f *Form) trapInterrupts(s *Strings) func() {
+	s.interrupted = new(int32)
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt)
+	done := make(chan struct{})
+	go func() {
+		for {
+			select {
+			case <-signals:
+				if atomic.CompareAndSwapInt32(s.interrupted, 0, 1) {
+					fmt.Print("\n" + s.ConfirmQuit)
+				}
+			case <-done:
+				return
+			}
+		}
+	}()
+	return func() {
+		signal.Stop(signals)
+		close(done)
+	}
+}
+
+// errQuit is returned by accessible prompts when the user answers with the
+// Quit answer to abort a form.
+var errQuit = errors.New("quit")
+
+// errQuitConfirmed is returned by accessible prompts when the user confirms
+// quitting after an interrupt, see Strings.interrupted.
+var errQuitConfirmed = fmt.Errorf("%w, confirmed", errQuit)
+
+// isQuit returns whether the input is the Quit answer and quitting is allowed.
+func isQuit(s *Strings, input string) bool {
+	return s.allowBack && strings.EqualFold(strings.TrimSpace(input), s.Quit)
+}
+
+// promptString prompts for a string, re-prompting until the validator accepts
+// the input. It returns errBack if the user asks to go back, errQuit if they
+// ask to quit and a goToError if they ask to jump to another question.
+//
+// An input read after an interrupt answers the confirmation of quitting
+// instead, returning errQuitConfirmed if confirmed or asking again if not.
+func promptString(s *Strings, prompt string, validator func(string) error) (string, error) {
+	var input string
+	for {
+		input = accessibility.PromptString(prompt, func(input string) error {
+			if s.interrupting() {
+				return nil
+			}
+			if _, ok := parseGoTo(s, input); ok || isBack(s, input) || isQuit(s, input) {
+				return nil
+			}
+			if err := validator(input); err != nil {
+				return errors.New(s.reprompt(err))
+			}
+			return nil
+		})
+		if s.interrupted == nil || !atomic.CompareAndSwapInt32(s.interrupted, 1, 0) {
+			break
+		}
+		if quit, err := parseBool(s, input); err == nil && quit {
+			return "", errQuitConfirmed
+		}
+		fmt.Println()
+	}
+	if isBack(s, input) {
+		return "", errBack
+	}
+	if isQuit(s, input) {
+		return "", errQuit
+	}
+	if n, ok := parseGoTo(s, input); ok {
+		return "", goToError{question: n}
+	}
+	return input, nil
+}
+
+// promptChoice prompts for the position of a choice between min and max,
+// re-prompting with the localized invalid input message until a valid choice
+// is given.
+//
+// Choices can be given as numbers or as letters, where a is 1.
+func promptChoice(s *Strings, prompt string, min, max int) (int, error) {
+	validChoice := func(input string) error {
+		i, err := parseChoice(input)
+		if err != nil || i < min || i > max {
+			return errors.New(s.InvalidInput)
+		}
+		return nil
+	}
+
+	input, err := promptString(s, prompt, validChoice)
+	if err != nil {
+		return 0, err
+	}
+	choice, _ := parseChoice(input)
+	return choice, nil
+}
+
+// parseChoice parses a choice given as a number or a letter.
+func parseChoice(input string) (int, error) {
+	input = strings.ToLower(strings.TrimSpace(input))
+	if len(input) == 1 && input[0] >= 'a' && input[0] <= 'z' {
+		return int(input[0]-'a') + 1, nil
+	}
+	return strconv.Atoi(input)
+}
+
+// interrupting returns whether the answer being read is the one to the
+// confirmation of quitting after an interrupt.
+func (s *Strings) interrupting() bool {
+	return s.interrupted != nil && atomic.LoadInt32(s.interrupted) == 1
+}
+
+// parseBool parses a boolean answer using the localized yes and no answers.
+func parseBool(s *Strings, input string) (bool, error) {
+	input = strings.ToLower(input)
+	for _, y := range s.Yes {
+		if strings.ToLower(y) == input {
+			return true, nil
+		}
+	}
+	for _, n := range s.No {
+		if strings.ToLower(n) == input {
+			return false, nil
+		}
+	}
+	return false, errors.New(s.InvalidInput)
+}
+
+// promptBool prompts for a boolean answer using the localized yes and no
+// answers.
+func promptBool(s *Strings, prompt string) (bool, error) {
+	validBool := func(input string) error {
+		_, err := parseBool(s, input)
+		return err
+	}
+
+	input, err := promptString(s, prompt, validBool)
+	if err != nil {
+		return false, err
+	}
+	b, _ := parseBool(s, input)
+	return b, nil
+}
@@ -0,0 +1,67 @@
+package huh
+
+// RunBatchCollect runs the form without prompting, setting the fields with
+// keys from answers, by key, as Load does, and validating every field of the
+// groups that aren't hidden. Fields without an answer keep their value.
+//
+// Rather than stopping at the first problem, it returns the values of the
+// form along with every failure, keyed by field: answers that can't be
+// converted to the type of their field and values that fail validation.
+// Fields without keys are keyed by their title, and the errors of group
+// validation by the title of the group. The form is completed if there are no
+// failures, in which case the map of errors is nil.
+func (f *Form) RunBatchCollect(answers map[string]any) (map[string]any, map[string]error) {
+	var errs map[string]error
+	fail := func(key string, err error) {
+		if errs == nil {
+			errs = make(map[string]error)
+		}
+		if _, ok := errs[key]; !ok {
+			errs[key] = err
+		}
+	}
+
+	for _, group := range f.groups {
+		if group.hide != nil && group.hide() {
+			continue
+		}
+		restore := group.submitting()
+		failed := false
+		for _, field := range group.fields {
+			key := field.GetKey()
+			if key == "" {
+				key = fieldTitle(field)
+			}
+			if setter, ok := field.(valueSetter); ok {
+				if answer, ok := answers[field.GetKey()]; ok && field.GetKey() != "" {
+					if err := setter.setValue(answer); err != nil {
+						fail(key, err)
+						failed = true
+						continue
+					}
+				}
+			}
+
+			if v, ok := field.(validator); ok {
+				v.validateField()
+			}
+			if err := field.Error(); err != nil {
+				fail(key, err)
+				failed = true
+				continue
+			}
+			f.results[field.GetKey()] = field.GetValue()
+		}
+		if !failed {
+			if err := group.runValidation(); err != nil {
+				fail(group.title, err)
+			}
+		}
+		restore()
+	}
+
+	if errs == nil {
+		f.State = StateCompleted
+	}
+	return f.Values(), errs
+}
@@ -0,0 +1,110 @@
+package huh
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// WithInitialValues sets the values, by key, that the form is editing, such
+// as those of an existing record, so that the edits can be found with Changes.
+//
+// This doesn't set the values of the fields, bind them or use Load to do so.
+func (f *Form) WithInitialValues(values map[string]any) *Form {
+	f.initialValues = values
+	return f
+}
+
+// Changes returns the fields with keys whose values differ from the initial
+// values set with WithInitialValues, as pairs of the initial and current
+// value by key. Fields without an initial value are left out, and secrets are
+// redacted.
+func (f *Form) Changes() map[string][2]any {
+	changes := make(map[string][2]any)
+	for _, field := range f.changedFields() {
+		initial := f.initialValues[field.GetKey()]
+		if s, ok := field.(secreter); ok && s.isSecret() {
+			initial = Redacted
+		}
+		changes[field.GetKey()] = [2]any{initial, publicValue(field)}
+	}
+	return changes
+}
+
+// changedFields returns the fields whose values differ from their initial
+// values, skipping hidden groups.
+func (f *Form) changedFields() []Field {
+	var fields []Field
+	for _, group := range f.groups {
+		if group.hide != nil && group.hide() {
+			continue
+		}
+		for _, field := range group.fields {
+			initial, ok := f.initialValues[field.GetKey()]
+			if !ok || field.GetKey() == "" || sameValue(initial, field.GetValue()) {
+				continue
+			}
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
+
+// ChangesView renders a summary of the fields with keys as single lines, with
+// the initial and current values of changed fields highlighted. It is useful
+// on a confirmation screen after editing.
+func (f *Form) ChangesView() string {
+	changed := make(map[Field]bool)
+	for _, field := range f.changedFields() {
+		changed[field] = true
+	}
+	changes := f.Changes()
+
+	var s strings.Builder
+	styles := f.theme.Blurred
+	for _, group := range f.groups {
+		if group.hide != nil && group.hide() {
+			continue
+		}
+		for _, field := range group.fields {
+			if field.GetKey() == "" {
+				continue
+			}
+			if !changed[field] {
+				s.WriteString(group.inlineView(field) + "\n")
+				continue
+			}
+			change := changes[field.GetKey()]
+			initial, value := changeText(field, change[0]), changeText(field, change[1])
+			s.WriteString(styles.Base.Render(styles.Title.Render(fieldTitle(field)+":")+" "+
+				styles.Description.Render(initial+" →")+" "+f.theme.Changed.Render(value)) + "\n")
+		}
+	}
+	return s.String()
+}
+
+// changeText returns the first line of a value of a field as shown in
+// ChangesView, converted to the type of the field's value first, so that the
+// initial and current values are formatted the same way.
+func changeText(field Field, value any) string {
+	if current := field.GetValue(); value != nil && current != nil {
+		if v, err := convertValue(reflect.ValueOf(value), reflect.TypeOf(current)); err == nil {
+			value = v.Interface()
+		}
+	}
+	text, _, _ := strings.Cut(fmt.Sprint(value), "\n")
+	return text
+}
+
+// sameValue reports whether an initial value is the same as the value of a
+// field, converting it to the type of the field's value first, see Load.
+func sameValue(initial, value any) bool {
+	if value == nil {
+		return initial == nil
+	}
+	v, err := convertValue(reflect.ValueOf(initial), reflect.TypeOf(value))
+	if err != nil {
+		return false
+	}
+	return reflect.DeepEqual(v.Interface(), value)
+}
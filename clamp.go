@@ -14,6 +14,16 @@ func max(a, b int) int {
 	return b
 }
 
+// minPercentWidth is the narrowest a width given as a percentage can get,
+// unless the available width is narrower.
+const minPercentWidth = 20
+
+// percentWidth returns percent of the given width, clamped to a minimum of
+// minPercentWidth and a maximum of width.
+func percentWidth(width, percent int) int {
+	return clamp(width*percent/100, min(minPercentWidth, width), width)
+}
+
 func clamp(n, low, high int) int {
 	if low > high {
 		low, high = high, low
@@ -0,0 +1,60 @@
+package huh
+
+import (
+	"time"
+
+	"github.com/atotto/clipboard"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Clipboard is where the Copy key writes the value of the focused field. It
+// defaults to the system clipboard and can be replaced, for example in tests
+// or where the system clipboard isn't available.
+type Clipboard interface {
+	WriteText(text string) error
+}
+
+// systemClipboard is the system clipboard.
+type systemClipboard struct{}
+
+// WriteText writes text to the system clipboard.
+func (systemClipboard) WriteText(text string) error {
+	return clipboard.WriteAll(text)
+}
+
+// copiedDuration is how long the copy confirmation is shown.
+const copiedDuration = 2 * time.Second
+
+// clearCopiedMsg clears the copy confirmation with the given id.
+type clearCopiedMsg int
+
+// WithClipboard sets the clipboard that the Copy key writes to.
+func (f *Form) WithClipboard(c Clipboard) *Form {
+	f.clipboard = c
+	return f
+}
+
+// copyValue writes the display value of the focused field to the clipboard.
+// If that fails, such as when there is no clipboard, nothing happens.
+func (f *Form) copyValue() tea.Cmd {
+	group := f.groups[f.paginator.Page]
+	value := displayValue(group.fields[group.paginator.Page])
+	if f.clipboard == nil || value == "" || f.clipboard.WriteText(value) != nil {
+		return nil
+	}
+
+	f.copies++
+	f.copied = true
+	id := f.copies
+	return tea.Tick(copiedDuration, func(time.Time) tea.Msg {
+		return clearCopiedMsg(id)
+	})
+}
+
+// copiedView renders the copy confirmation, if a value was just copied.
+func (f *Form) copiedView() string {
+	if !f.copied {
+		return ""
+	}
+	return "\n" + f.theme.Copied.Render(f.strings.Copied)
+}
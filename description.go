@@ -0,0 +1,53 @@
+package huh
+
+import "strings"
+
+// descriptionScroller is implemented by fields whose description can be
+// scrolled when it's taller than its height.
+type descriptionScroller interface {
+	scrollDescription(delta int)
+}
+
+// descriptionScroll is the scrollable region of a field's description.
+// A height of zero shows the whole description.
+type descriptionScroll struct {
+	height int
+	offset int
+}
+
+// view renders the visible lines of the description, marking the first and
+// last ones with indicators when there is more above or below.
+func (d *descriptionScroll) view(description string) string {
+	lines := strings.Split(description, "\n")
+	if d.height <= 0 || len(lines) <= d.height {
+		return description
+	}
+	d.offset = clamp(d.offset, 0, len(lines)-d.height)
+
+	visible := append([]string(nil), lines[d.offset:d.offset+d.height]...)
+	if d.offset > 0 {
+		visible[0] += " ↑"
+	}
+	if d.offset+d.height < len(lines) {
+		visible[len(visible)-1] += " ↓"
+	}
+	return strings.Join(visible, "\n")
+}
+
+// scroll scrolls the description by delta lines.
+func (d *descriptionScroll) scroll(description string, delta int) {
+	lines := strings.Count(description, "\n") + 1
+	if d.height <= 0 || lines <= d.height {
+		return
+	}
+	d.offset = clamp(d.offset+delta, 0, lines-d.height)
+}
+
+// scrollDescription scrolls the description of the focused field, if it can
+// be scrolled.
+func (f *Form) scrollDescription(delta int) {
+	group := f.groups[f.paginator.Page]
+	if s, ok := group.fields[group.paginator.Page].(descriptionScroller); ok {
+		s.scrollDescription(delta)
+	}
+}
@@ -0,0 +1,13 @@
+package huh
+
+import "os"
+
+// lookupEnv returns the value of the named environment variable, and whether
+// it is set to a non-empty value.
+func lookupEnv(name string) (string, bool) {
+	if name == "" {
+		return "", false
+	}
+	value, ok := os.LookupEnv(name)
+	return value, ok && value != ""
+}
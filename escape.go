@@ -0,0 +1,112 @@
+package huh
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Escape sequences such as hyperlinks and images can't be written into text
+// that is still to be rendered: lipgloss counts them as visible text, and
+// markdown rendering breaks them up. Zero-width markers, which survive both,
+// stand in for them instead, and the rendered form replaces the markers with
+// the escape sequences.
+const (
+	escapeMark = '\ufeff' // zero width no-break space, around a marker
+	escapeZero = '\u200b' // zero width space, a 0 bit of an escape's id
+	escapeOne  = '\u200c' // zero width non-joiner, a 1 bit of an escape's id
+
+	markLen = len(string(escapeMark))
+)
+
+// deferredEscape is an escape sequence standing in for a marker, along with
+// the plain text written in its place in accessible output.
+type deferredEscape struct {
+	seq   string
+	plain string
+}
+
+// escapes are the escape sequences deferred so far, by their id. The same
+// sequence always gets the same id, so that rendering the same links and
+// images again doesn't add to them.
+var escapes struct {
+	sync.Mutex
+	list []deferredEscape
+	ids  map[deferredEscape]int
+}
+
+// deferEscape returns the marker standing in for an escape sequence until the
+// form is rendered, which writes plain in its place in accessible output.
+func deferEscape(seq, plain string) string {
+	escapes.Lock()
+	e := deferredEscape{seq: seq, plain: plain}
+	id, ok := escapes.ids[e]
+	if !ok {
+		if escapes.ids == nil {
+			escapes.ids = make(map[deferredEscape]int)
+		}
+		id = len(escapes.list)
+		escapes.ids[e] = id
+		escapes.list = append(escapes.list, e)
+	}
+	escapes.Unlock()
+
+	var sb strings.Builder
+	sb.WriteRune(escapeMark)
+	for _, bit := range strconv.FormatInt(int64(id), 2) {
+		if bit == '0' {
+			sb.WriteRune(escapeZero)
+		} else {
+			sb.WriteRune(escapeOne)
+		}
+	}
+	sb.WriteRune(escapeMark)
+	return sb.String()
+}
+
+// replaceEscapes replaces the markers in text with what replace returns for
+// their escapes. Text without markers is left as it is.
+func replaceEscapes(text string, replace func(deferredEscape) string) string {
+	var sb strings.Builder
+	for {
+		start := strings.IndexRune(text, escapeMark)
+		if start < 0 {
+			break
+		}
+		bits := text[start+markLen:]
+		end := strings.IndexRune(bits, escapeMark)
+		if end < 0 {
+			break
+		}
+		bits = bits[:end]
+		sb.WriteString(text[:start])
+		text = text[start+markLen+end+markLen:]
+
+		id := 0
+		for _, bit := range bits {
+			id <<= 1
+			if bit == escapeOne {
+				id |= 1
+			}
+		}
+		escapes.Lock()
+		if id < len(escapes.list) {
+			sb.WriteString(replace(escapes.list[id]))
+		}
+		escapes.Unlock()
+	}
+	sb.WriteString(text)
+	return sb.String()
+}
+
+// writeEscapes replaces the markers in a rendered view with their escape
+// sequences.
+func writeEscapes(view string) string {
+	return replaceEscapes(view, func(e deferredEscape) string { return e.seq })
+}
+
+// plainEscapes replaces the markers in text with their plain text, for
+// accessible output.
+func plainEscapes(text string) string {
+	return replaceEscapes(text, func(e deferredEscape) string { return e.plain })
+}
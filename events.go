@@ -0,0 +1,165 @@
+package huh
+
+import (
+	"reflect"
+	"time"
+)
+
+// EventType is the kind of interaction an Event describes.
+type EventType int
+
+const (
+	// EventFocus is emitted when a field gains focus.
+	EventFocus EventType = iota
+
+	// EventValueChange is emitted when the value of a field changes.
+	EventValueChange
+
+	// EventValidationError is emitted when a field fails validation.
+	EventValidationError
+
+	// EventSubmit is emitted when the form is submitted.
+	EventSubmit
+
+	// EventAbort is emitted when the user aborts the form.
+	EventAbort
+)
+
+// String returns the name of the event type.
+func (t EventType) String() string {
+	switch t {
+	case EventFocus:
+		return "focus"
+	case EventValueChange:
+		return "value change"
+	case EventValidationError:
+		return "validation error"
+	case EventSubmit:
+		return "submit"
+	case EventAbort:
+		return "abort"
+	}
+	return "unknown"
+}
+
+// Event describes an interaction with a form.
+type Event struct {
+	Type EventType
+
+	// Key is the key of the field the event relates to. It is empty for
+	// submit and abort events.
+	Key string
+
+	// Value is the value of the field for value change events.
+	Value any
+
+	// Err is the validation error for validation error events.
+	Err error
+
+	Time time.Time
+}
+
+// eventBufferSize is the number of events that can be waiting for the event
+// handler before new events are dropped.
+const eventBufferSize = 128
+
+// WithEventHandler sets a function that is called with the interactions of
+// the user with the form, such as focus and value changes.
+//
+// The handler is called in order on its own goroutine so that it never blocks
+// rendering. Up to 128 events are buffered while the handler is busy, and
+// events beyond that are dropped until it catches up. The goroutine ends
+// once the form is submitted or aborted, and a form that is run again starts
+// a new one, which keeps calling the handler in order.
+func (f *Form) WithEventHandler(handler func(Event)) *Form {
+	f.eventHandler = handler
+	return f
+}
+
+// emit sends an event to the event handler, if any.
+func (f *Form) emit(t EventType, key string, value any, err error) {
+	if f.eventHandler == nil {
+		return
+	}
+	if f.events == nil {
+		// The handler of a form run again waits for the events of the
+		// previous run.
+		prev, done := f.eventsDone, make(chan struct{})
+		f.events, f.eventsDone = make(chan Event, eventBufferSize), done
+		go func(events <-chan Event, handler func(Event), recovering bool) {
+			defer close(done)
+			if prev != nil {
+				<-prev
+			}
+			for event := range events {
+				func() {
+					var err error
+					defer recoverAsError(recovering, &err)
+					handler(event)
+				}()
+			}
+		}(f.events, f.eventHandler, !f.failFast)
+	}
+
+	select {
+	case f.events <- Event{Type: t, Key: key, Value: value, Err: err, Time: time.Now()}:
+	default:
+		// The buffer is full, see WithEventHandler.
+	}
+}
+
+// closeEvents ends the goroutine calling the event handler once it has
+// handled the events sent so far. Later events start a new one.
+func (f *Form) closeEvents() {
+	if f.events != nil {
+		close(f.events)
+		f.events = nil
+	}
+}
+
+// fieldSnapshot is the state of the focused field of a form, used to find
+// which events an update caused.
+type fieldSnapshot struct {
+	field Field
+	value any
+	err   error
+}
+
+// snapshot returns the state of the focused field.
+func (f *Form) snapshot() fieldSnapshot {
+	group := f.groups[f.paginator.Page]
+	if len(group.fields) == 0 {
+		return fieldSnapshot{}
+	}
+	field := group.fields[group.paginator.Page]
+	return fieldSnapshot{field: field, value: field.GetValue(), err: field.Error()}
+}
+
+// emitChanges emits the events caused by an update, given the state of the
+// focused field before the update.
+func (f *Form) emitChanges(before fieldSnapshot) {
+	if f.eventHandler == nil || before.field == nil {
+		return
+	}
+
+	field := before.field
+	if value := field.GetValue(); !reflect.DeepEqual(value, before.value) {
+		f.emit(EventValueChange, field.GetKey(), publicValue(field), nil)
+	}
+	if err := field.Error(); err != nil && (before.err == nil || err.Error() != before.err.Error()) {
+		f.emit(EventValidationError, field.GetKey(), nil, err)
+	}
+
+	switch f.State {
+	case StateCompleted:
+		f.emit(EventSubmit, "", nil, nil)
+		f.closeEvents()
+	case StateAborted:
+		f.emit(EventAbort, "", nil, nil)
+		f.closeEvents()
+	default:
+		if after := f.snapshot(); after.field != nil && after.field != field {
+			f.emit(EventFocus, after.field.GetKey(), nil, nil)
+		}
+	}
+}
@@ -0,0 +1,331 @@
+package huh
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// ButtonAction is what pressing a button does.
+type ButtonAction int
+
+const (
+	// ButtonSubmit submits the form.
+	ButtonSubmit ButtonAction = iota
+
+	// ButtonCancel aborts the form, like quitting.
+	ButtonCancel
+)
+
+// button is a button of a button field.
+type button struct {
+	label  string
+	action ButtonAction
+}
+
+// Button is a form field of one or more buttons side by side, such as Submit
+// and Cancel, for forms that finish with an explicit action rather than on the
+// last field. It usually goes at the end of the last group.
+type Button struct {
+	// customization
+	title       string
+	description string
+	titleFunc   titleFunc
+	buttons     []button
+
+	// state
+	selected int
+	pressed  bool
+	focused  bool
+
+	// options
+	width          int
+	tabIndex       int
+	accessible     bool
+	updateHook     func(tea.Msg) tea.Msg
+	noPadding      bool
+	descVisibility DescriptionVisibility
+	descScroll     descriptionScroll
+	theme          *Theme
+	keymap         *ButtonKeyMap
+	strings        *Strings
+}
+
+// NewButton returns a new button field. Without buttons added with Add, it
+// has a single submit button labeled with the field's strings.
+func NewButton() *Button {
+	return withDefaults(&Button{
+		strings: DefaultStrings(),
+	})
+}
+
+// Title sets the title of the button field.
+func (b *Button) Title(title string) *Button {
+	b.title = title
+	return b
+}
+
+// TitleFunc sets a function computing the title of the button field, which
+// is recomputed when the value bindings points to changes.
+func (b *Button) TitleFunc(f func() string, bindings any) *Button {
+	b.titleFunc = titleFunc{f: f, bindings: bindings}
+	b.titleFunc.update(&b.title)
+	return b
+}
+
+// updateBindings recomputes the title of the button field if the value of
+// its bindings changed.
+func (b *Button) updateBindings() tea.Cmd {
+	b.titleFunc.update(&b.title)
+	return nil
+}
+
+// Description sets the description of the button field.
+func (b *Button) Description(description string) *Button {
+	b.description = description
+	return b
+}
+
+// Add adds a button with the given label and action to the button field.
+func (b *Button) Add(label string, action ButtonAction) *Button {
+	b.buttons = append(b.buttons, button{label: label, action: action})
+	return b
+}
+
+// allButtons returns the buttons of the button field, or the default submit
+// button.
+func (b *Button) allButtons() []button {
+	if len(b.buttons) > 0 {
+		return b.buttons
+	}
+	return []button{{label: b.strings.SubmitButton, action: ButtonSubmit}}
+}
+
+// TabIndex sets the position of the button field in its group's focus
+// order, see Group for how tab indices are ordered.
+func (b *Button) TabIndex(index int) *Button {
+	b.tabIndex = index
+	return b
+}
+
+// getTabIndex returns the tab index of the button field.
+func (b *Button) getTabIndex() int {
+	return b.tabIndex
+}
+
+// Focus focuses the button field.
+func (b *Button) Focus() tea.Cmd {
+	b.focused = true
+	return nil
+}
+
+// Blur blurs the button field.
+func (b *Button) Blur() tea.Cmd {
+	b.focused = false
+	return nil
+}
+
+// Error returns the error of the button field.
+func (b *Button) Error() error {
+	return nil
+}
+
+// SetError does nothing, buttons can't have errors.
+func (b *Button) SetError(error) {}
+
+// Warning returns the warning of the button field.
+func (b *Button) Warning() string {
+	return ""
+}
+
+// KeyBinds returns the help message for the button field.
+func (b *Button) KeyBinds() []key.Binding {
+	if len(b.allButtons()) > 1 {
+		return []key.Binding{b.keymap.Left, b.keymap.Right, b.keymap.Press, b.keymap.Prev}
+	}
+	return []key.Binding{b.keymap.Press, b.keymap.Prev}
+}
+
+// Init initializes the button field.
+func (b *Button) Init() tea.Cmd {
+	b.titleFunc.update(&b.title)
+	return nil
+}
+
+// Update updates the button field.
+func (b *Button) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg = runUpdateHook(b.updateHook, msg); msg == nil {
+		return b, nil
+	}
+
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		buttons := b.allButtons()
+		switch {
+		case key.Matches(msg, b.keymap.Left):
+			b.selected = max(b.selected-1, 0)
+		case key.Matches(msg, b.keymap.Right):
+			b.selected = min(b.selected+1, len(buttons)-1)
+		case key.Matches(msg, b.keymap.Press):
+			b.pressed = true
+			if buttons[b.selected].action == ButtonCancel {
+				return b, cancelForm
+			}
+			return b, submitForm
+		case key.Matches(msg, b.keymap.Prev):
+			return b, prevField
+		case key.Matches(msg, b.keymap.Next):
+			return b, nextField
+		}
+	}
+	return b, nil
+}
+
+// View renders the button field.
+func (b *Button) View() string {
+	styles := b.theme.Blurred
+	if b.focused {
+		styles = b.theme.Focused
+	}
+
+	var sb strings.Builder
+	if b.title != "" {
+		sb.WriteString(styles.Title.Render(b.title) + "\n")
+	}
+	if b.description != "" && b.descVisibility.show(b.focused) {
+		sb.WriteString(styles.Description.Render(b.descScroll.view(b.description)) + "\n")
+	}
+
+	buttons := b.allButtons()
+	views := make([]string, len(buttons))
+	for i, button := range buttons {
+		style := styles.BlurredButton
+		if b.focused && i == b.selected {
+			style = styles.FocusedButton
+		}
+		views[i] = style.Render(button.label)
+	}
+	sb.WriteString(lipgloss.JoinHorizontal(lipgloss.Center, views...))
+	return fieldBase(styles, b.noPadding).Render(sb.String())
+}
+
+// Run runs the button field.
+func (b *Button) Run() error {
+	if b.accessible {
+		return b.runAccessible()
+	}
+	return Run(b)
+}
+
+// runAccessible runs an accessible button field, choosing a button by number.
+func (b *Button) runAccessible() error {
+	var sb strings.Builder
+	if b.title != "" {
+		sb.WriteString(b.theme.Focused.Title.Render(b.title) + "\n")
+	}
+	buttons := b.allButtons()
+	for i, button := range buttons {
+		sb.WriteString(b.strings.formatOption(i, button.label) + "\n")
+	}
+	fmt.Println(accessibleBlock(b.theme.Blurred.Base, sb.String(), b.width))
+
+	choice, err := promptChoice(b.strings, b.strings.Choose, 1, len(buttons))
+	if err != nil {
+		return err
+	}
+	b.selected, b.pressed = choice-1, true
+	button := buttons[b.selected]
+	fmt.Println(b.theme.Focused.SelectedOption.Render(b.strings.Chose + button.label + "\n"))
+	if button.action == ButtonCancel {
+		return ErrUserAborted
+	}
+	return nil
+}
+
+// WithTheme sets the theme of the button field.
+func (b *Button) WithTheme(theme *Theme) Field {
+	b.theme = theme
+	return b
+}
+
+// WithKeyMap sets the keymap of the button field.
+func (b *Button) WithKeyMap(k *KeyMap) Field {
+	b.keymap = &k.Button
+	return b
+}
+
+// WithAccessible sets the accessible mode of the button field.
+func (b *Button) WithAccessible(accessible bool) Field {
+	b.accessible = accessible
+	return b
+}
+
+// WithStrings sets the user-facing strings of the button field.
+func (b *Button) WithStrings(strings *Strings) Field {
+	b.strings = strings
+	return b
+}
+
+// WithWidth sets the width of the button field.
+func (b *Button) WithWidth(width int) Field {
+	b.width = width
+	return b
+}
+
+// WithoutPadding sets whether the button field is rendered without the
+// borders and padding of its theme.
+func (b *Button) WithoutPadding(v bool) Field {
+	b.noPadding = v
+	return b
+}
+
+// WithDescriptionVisibility sets when the description of the button field is
+// shown.
+func (b *Button) WithDescriptionVisibility(v DescriptionVisibility) Field {
+	b.descVisibility = v
+	return b
+}
+
+// WithDescriptionHeight limits the description of the button field to the
+// given number of lines.
+func (b *Button) WithDescriptionHeight(height int) Field {
+	b.descScroll.height = height
+	return b
+}
+
+// scrollDescription scrolls the description of the button field.
+func (b *Button) scrollDescription(delta int) {
+	b.descScroll.scroll(b.description, delta)
+}
+
+// WithUpdateHook sets the update hook of the button field, see
+// Input.WithUpdateHook.
+func (b *Button) WithUpdateHook(hook func(tea.Msg) tea.Msg) Field {
+	b.updateHook = hook
+	return b
+}
+
+// GetKey satisfies the Field interface, buttons do not have keys.
+func (b *Button) GetKey() string {
+	return ""
+}
+
+// GetValue satisfies the Field interface, buttons do not have values.
+func (b *Button) GetValue() any {
+	return nil
+}
+
+// GetTitle returns the title of the button field.
+func (b *Button) GetTitle() string {
+	return b.title
+}
+
+// DisplayValue returns the label of the pressed button, if any.
+func (b *Button) DisplayValue() string {
+	if !b.pressed {
+		return ""
+	}
+	return b.allButtons()[b.selected].label
+}
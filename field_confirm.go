@@ -1,12 +1,13 @@
 package huh
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
 	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
-	"github.com/charmbracelet/huh/accessibility"
 	"github.com/charmbracelet/lipgloss"
 )
 
@@ -18,31 +19,47 @@ type Confirm struct {
 	// customization
 	title       string
 	description string
+	titleFunc   titleFunc
 	affirmative string
 	negative    string
 
+	// type-to-confirm
+	phrase string
+	input  textinput.Model
+
 	// error handling
 	validate func(bool) error
 	err      error
+	setErr   setError
+	warn     func(bool) string
+	warning  string
 
 	// state
 	focused bool
 
 	// options
-	width      int
-	accessible bool
-	theme      *Theme
-	keymap     *ConfirmKeyMap
+	width          int
+	tabIndex       int
+	accessible     bool
+	updateHook     func(tea.Msg) tea.Msg
+	failFast       bool
+	validationMode ValidationMode
+	noPadding      bool
+	descVisibility DescriptionVisibility
+	descScroll     descriptionScroll
+	theme          *Theme
+	keymap         *ConfirmKeyMap
+	strings        *Strings
 }
 
 // NewConfirm returns a new confirm field.
 func NewConfirm() *Confirm {
-	return &Confirm{
-		value:       new(bool),
-		affirmative: "Yes",
-		negative:    "No",
-		validate:    func(bool) error { return nil },
-	}
+	return withDefaults(&Confirm{
+		value:    new(bool),
+		validate: func(bool) error { return nil },
+		warn:     func(bool) string { return "" },
+		strings:  DefaultStrings(),
+	})
 }
 
 // Validate sets the validation function of the confirm field.
@@ -56,24 +73,90 @@ func (c *Confirm) Error() error {
 	return c.err
 }
 
+// Warn sets the warning function of the confirm field.
+//
+// Warnings are shown like errors but don't prevent the user from moving on,
+// which is useful for unusual but allowed values. A warning is returned as a
+// non-empty string.
+func (c *Confirm) Warn(warn func(bool) string) *Confirm {
+	c.warn = warn
+	return c
+}
+
+// Warning returns the warning of the confirm field.
+func (c *Confirm) Warning() string {
+	return c.warning
+}
+
+// SetError sets an error on the confirm field, which is shown until it is
+// cleared with nil or the value changes.
+func (c *Confirm) SetError(err error) {
+	c.err = err
+	c.setErr = setError{err: err, value: *c.value}
+}
+
+// validateField validates the value of the confirm field.
+func (c *Confirm) validateField() {
+	c.runValidation(*c.value)
+}
+
+// runValidation validates the value, setting the error and warning of the
+// confirm field.
+func (c *Confirm) runValidation(value bool) {
+	if c.validationMode == ValidationOnSubmit {
+		return
+	}
+	defer recoverAsError(!c.failFast, &c.err)
+	c.err = c.setErr.get(value)
+	if c.err == nil {
+		c.err = c.validate(value)
+	}
+	c.warning = c.warn(value)
+}
+
 // Affirmative sets the affirmative value of the confirm field.
+//
+// When unset, the affirmative label of the field's strings is used.
 func (c *Confirm) Affirmative(affirmative string) *Confirm {
 	c.affirmative = affirmative
 	return c
 }
 
 // Negative sets the negative value of the confirm field.
+//
+// When unset, the negative label of the field's strings is used.
 func (c *Confirm) Negative(negative string) *Confirm {
 	c.negative = negative
 	return c
 }
 
+// RequirePhrase requires the user to type the given phrase to confirm.
+//
+// The confirm's value is only affirmative once the exact phrase has been
+// typed, which is useful to guard destructive actions.
+func (c *Confirm) RequirePhrase(phrase string) *Confirm {
+	c.phrase = phrase
+	c.input = textinput.New()
+	c.input.Placeholder = phrase
+	return c
+}
+
 // Value sets the value of the confirm field.
 func (c *Confirm) Value(value *bool) *Confirm {
 	c.value = value
 	return c
 }
 
+// setValue sets the value of the confirm field.
+func (c *Confirm) setValue(value any) error {
+	v, err := convert[bool](value)
+	if err != nil {
+		return err
+	}
+	*c.value = v
+	return nil
+}
+
 // Key sets the key of the confirm field.
 func (c *Confirm) Key(key string) *Confirm {
 	c.key = key
@@ -86,45 +169,97 @@ func (c *Confirm) Title(title string) *Confirm {
 	return c
 }
 
+// TitleFunc sets a function computing the title of the confirm field, which
+// is recomputed when the value bindings points to changes.
+func (c *Confirm) TitleFunc(f func() string, bindings any) *Confirm {
+	c.titleFunc = titleFunc{f: f, bindings: bindings}
+	c.titleFunc.update(&c.title)
+	return c
+}
+
+// updateBindings recomputes the title of the confirm field if the value of
+// its bindings changed.
+func (c *Confirm) updateBindings() tea.Cmd {
+	c.titleFunc.update(&c.title)
+	return nil
+}
+
 // Description sets the description of the confirm field.
 func (c *Confirm) Description(description string) *Confirm {
 	c.description = description
 	return c
 }
 
+// TabIndex sets the position of the confirm field in its group's focus
+// order, see Group for how tab indices are ordered.
+func (c *Confirm) TabIndex(index int) *Confirm {
+	c.tabIndex = index
+	return c
+}
+
+// getTabIndex returns the tab index of the confirm field.
+func (c *Confirm) getTabIndex() int {
+	return c.tabIndex
+}
+
 // Focus focuses the confirm field.
 func (c *Confirm) Focus() tea.Cmd {
 	c.focused = true
+	if c.phrase != "" {
+		return c.input.Focus()
+	}
 	return nil
 }
 
 // Blur blurs the confirm field.
 func (c *Confirm) Blur() tea.Cmd {
 	c.focused = false
-	c.err = c.validate(*c.value)
+	c.input.Blur()
+	c.runValidation(*c.value)
 	return nil
 }
 
+// enteringText returns whether the confirm field takes typed text. Confirm
+// fields take text when a phrase is required.
+func (c *Confirm) enteringText() bool {
+	return c.phrase != ""
+}
+
 // KeyBinds returns the help message for the confirm field.
 func (c *Confirm) KeyBinds() []key.Binding {
+	if c.phrase != "" {
+		return []key.Binding{c.keymap.Next, c.keymap.Prev}
+	}
 	return []key.Binding{c.keymap.Toggle, c.keymap.Next, c.keymap.Prev}
 }
 
 // Init initializes the confirm field.
 func (c *Confirm) Init() tea.Cmd {
+	c.titleFunc.update(&c.title)
 	return nil
 }
 
 // Update updates the confirm field.
 func (c *Confirm) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg = runUpdateHook(c.updateHook, msg); msg == nil {
+		return c, nil
+	}
+
 	var cmds []tea.Cmd
 
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
 
 		c.err = nil
+		defer func() { c.setErr.keep(&c.err, *c.value) }()
+		c.warning = ""
 
 		switch {
+		case c.phrase != "" && !key.Matches(msg, c.keymap.Next, c.keymap.Prev):
+			var cmd tea.Cmd
+			c.input, cmd = c.input.Update(msg)
+			*c.value = c.input.Value() == c.phrase
+			cmds = append(cmds, cmd)
 		case key.Matches(msg, c.keymap.Toggle):
 			v := !*c.value
 			*c.value = v
@@ -140,37 +275,52 @@ func (c *Confirm) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 // View renders the confirm field.
 func (c *Confirm) View() string {
-	styles := c.theme.Blurred
-	if c.focused {
-		styles = c.theme.Focused
-	}
+	styles := c.theme.fieldStyles(c.focused, c.err)
 
 	var sb strings.Builder
 	sb.WriteString(styles.Title.Render(c.title))
 	if c.err != nil {
 		sb.WriteString(styles.ErrorIndicator.String())
+	} else if c.warning != "" {
+		sb.WriteString(styles.WarningIndicator.String())
 	}
-	if c.description != "" {
+	if c.description != "" && c.descVisibility.show(c.focused) {
 		sb.WriteString("\n")
-		sb.WriteString(styles.Description.Render(c.description))
+		sb.WriteString(styles.Description.Render(c.descScroll.view(c.description)))
 	}
 	sb.WriteString("\n")
+	if c.phrase != "" {
+		sb.WriteString(c.phraseView(styles))
+	}
 	sb.WriteString("\n")
 
 	if *c.value {
 		sb.WriteString(lipgloss.JoinHorizontal(
 			lipgloss.Center,
-			styles.FocusedButton.Render(c.affirmative),
-			styles.BlurredButton.Render(c.negative),
+			styles.FocusedButton.Render(c.affirmativeLabel()),
+			styles.BlurredButton.Render(c.negativeLabel()),
 		))
 	} else {
 		sb.WriteString(lipgloss.JoinHorizontal(
 			lipgloss.Center,
-			styles.BlurredButton.Render(c.affirmative),
-			styles.FocusedButton.Render(c.negative),
+			styles.BlurredButton.Render(c.affirmativeLabel()),
+			styles.FocusedButton.Render(c.negativeLabel()),
 		))
 	}
-	return styles.Base.Render(sb.String())
+	return fieldBase(styles, c.noPadding).Render(sb.String())
+}
+
+// phraseView renders the input of a type-to-confirm field, styling the typed
+// text as a mismatch as soon as it diverges from the phrase.
+func (c *Confirm) phraseView(styles FieldStyles) string {
+	c.input.PromptStyle = styles.TextInput.Prompt
+	c.input.PlaceholderStyle = styles.placeholder()
+	c.input.Cursor.Style = styles.TextInput.Cursor
+	c.input.TextStyle = styles.TextInput.Text
+	if !strings.HasPrefix(c.phrase, c.input.Value()) {
+		c.input.TextStyle = styles.ErrorMessage.Copy().UnsetString()
+	}
+	return c.input.View() + "\n"
 }
 
 // Run runs the confirm field in accessible mode.
@@ -183,18 +333,58 @@ func (c *Confirm) Run() error {
 
 // runAccessible runs the confirm field in accessible mode.
 func (c *Confirm) runAccessible() error {
-	fmt.Println(c.theme.Blurred.Base.Render(c.theme.Focused.Title.Render(c.title)))
+	fmt.Println(accessibleBlock(c.theme.Blurred.Base, c.theme.Focused.Title.Render(c.title), c.width))
 	fmt.Println()
-	*c.value = accessibility.PromptBool()
-	fmt.Println(c.theme.Focused.SelectedOption.Render("Chose: "+c.String()) + "\n")
+	var (
+		value bool
+		err   error
+	)
+	if c.phrase != "" {
+		value, err = c.promptPhrase()
+	} else {
+		value, err = promptBool(c.strings, c.strings.ConfirmPrompt)
+	}
+	if err != nil {
+		return err
+	}
+	*c.value = value
+	fmt.Println(c.theme.Focused.SelectedOption.Render(c.strings.Chose+c.String()) + "\n")
 	return nil
 }
 
+// promptPhrase prompts until the phrase is typed or the user cancels by
+// entering nothing.
+func (c *Confirm) promptPhrase() (bool, error) {
+	input, err := promptString(c.strings, fmt.Sprintf(c.strings.ConfirmPhrase, c.phrase), func(input string) error {
+		if input != "" && input != c.phrase {
+			return errors.New(c.strings.PhraseMismatch)
+		}
+		return nil
+	})
+	return input == c.phrase, err
+}
+
 func (c *Confirm) String() string {
 	if *c.value {
+		return c.affirmativeLabel()
+	}
+	return c.negativeLabel()
+}
+
+// affirmativeLabel returns the label of the affirmative button.
+func (c *Confirm) affirmativeLabel() string {
+	if c.affirmative != "" {
 		return c.affirmative
 	}
-	return c.negative
+	return c.strings.Affirmative
+}
+
+// negativeLabel returns the label of the negative button.
+func (c *Confirm) negativeLabel() string {
+	if c.negative != "" {
+		return c.negative
+	}
+	return c.strings.Negative
 }
 
 // WithTheme sets the theme of the confirm field.
@@ -215,12 +405,62 @@ func (c *Confirm) WithAccessible(accessible bool) Field {
 	return c
 }
 
+// WithStrings sets the user-facing strings of the confirm field.
+func (c *Confirm) WithStrings(strings *Strings) Field {
+	c.strings = strings
+	return c
+}
+
 // WithWidth sets the accessible mode of the confirm field.
 func (c *Confirm) WithWidth(width int) Field {
 	c.width = width
 	return c
 }
 
+// WithoutPadding sets whether the confirm field is rendered without the borders
+// and padding of its theme.
+func (c *Confirm) WithoutPadding(v bool) Field {
+	c.noPadding = v
+	return c
+}
+
+// WithDescriptionVisibility sets when the description of the confirm field is
+// shown.
+func (c *Confirm) WithDescriptionVisibility(v DescriptionVisibility) Field {
+	c.descVisibility = v
+	return c
+}
+
+// WithDescriptionHeight limits the description of the confirm field to the
+// given number of lines.
+func (c *Confirm) WithDescriptionHeight(height int) Field {
+	c.descScroll.height = height
+	return c
+}
+
+// scrollDescription scrolls the description of the confirm field.
+func (c *Confirm) scrollDescription(delta int) {
+	c.descScroll.scroll(c.description, delta)
+}
+
+// WithUpdateHook sets the update hook of the confirm field, see
+// Input.WithUpdateHook.
+func (c *Confirm) WithUpdateHook(hook func(tea.Msg) tea.Msg) Field {
+	c.updateHook = hook
+	return c
+}
+
+// withRecover sets whether the confirm field recovers from panics in its
+// callbacks.
+func (c *Confirm) withRecover(v bool) {
+	c.failFast = !v
+}
+
+// withValidationMode sets when the confirm field validates its value.
+func (c *Confirm) withValidationMode(mode ValidationMode) {
+	c.validationMode = mode
+}
+
 // GetKey returns the key of the field.
 func (c *Confirm) GetKey() string {
 	return c.key
@@ -230,3 +470,13 @@ func (c *Confirm) GetKey() string {
 func (c *Confirm) GetValue() any {
 	return *c.value
 }
+
+// GetTitle returns the title of the field.
+func (c *Confirm) GetTitle() string {
+	return c.title
+}
+
+// DisplayValue returns the label of the chosen button.
+func (c *Confirm) DisplayValue() string {
+	return c.String()
+}
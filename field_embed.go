@@ -0,0 +1,361 @@
+package huh
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Embed is a form field that embeds an arbitrary Bubble Tea model, such as a
+// custom widget, in a form.
+//
+// Messages are forwarded to the model and its view is rendered in place of
+// the field. The model may optionally implement Focus() tea.Cmd, Blur() or
+// Blur() tea.Cmd, SetWidth(int) and ShortHelp() []key.Binding to take part in
+// focus handling, sizing and help, models that don't are still rendered.
+type Embed struct {
+	model    tea.Model
+	getValue func() any
+	value    any
+	key      string
+
+	// customization
+	title       string
+	description string
+	titleFunc   titleFunc
+
+	// error handling
+	validate func(any) error
+	err      error
+	setErr   setError
+
+	// state
+	focused bool
+
+	// options
+	width          int
+	tabIndex       int
+	accessible     bool
+	updateHook     func(tea.Msg) tea.Msg
+	failFast       bool
+	noPadding      bool
+	descVisibility DescriptionVisibility
+	descScroll     descriptionScroll
+	theme          *Theme
+	keymap         *EmbedKeyMap
+	strings        *Strings
+}
+
+// NewEmbed returns a new field embedding the given model. The value of the
+// field is pulled from the model with the given getter when the field is
+// blurred.
+func NewEmbed(model tea.Model, value func() any) *Embed {
+	if value == nil {
+		value = func() any { return nil }
+	}
+	return withDefaults(&Embed{
+		model:    model,
+		getValue: value,
+		validate: func(any) error { return nil },
+		strings:  DefaultStrings(),
+	})
+}
+
+// Key sets the key of the embed field.
+func (e *Embed) Key(key string) *Embed {
+	e.key = key
+	return e
+}
+
+// Title sets the title of the embed field.
+func (e *Embed) Title(title string) *Embed {
+	e.title = title
+	return e
+}
+
+// TitleFunc sets a function computing the title of the embed field, which
+// is recomputed when the value bindings points to changes.
+func (e *Embed) TitleFunc(f func() string, bindings any) *Embed {
+	e.titleFunc = titleFunc{f: f, bindings: bindings}
+	e.titleFunc.update(&e.title)
+	return e
+}
+
+// updateBindings recomputes the title of the embed field if the value of
+// its bindings changed.
+func (e *Embed) updateBindings() tea.Cmd {
+	e.titleFunc.update(&e.title)
+	return nil
+}
+
+// Description sets the description of the embed field.
+func (e *Embed) Description(description string) *Embed {
+	e.description = description
+	return e
+}
+
+// Validate sets the validation function of the embed field, which is given
+// the value pulled from the model.
+func (e *Embed) Validate(validate func(any) error) *Embed {
+	e.validate = validate
+	return e
+}
+
+// Model returns the embedded model.
+func (e *Embed) Model() tea.Model {
+	return e.model
+}
+
+// Error returns the error of the embed field.
+func (e *Embed) Error() error {
+	return e.err
+}
+
+// Warning returns the warning of the embed field.
+func (e *Embed) Warning() string {
+	return ""
+}
+
+// SetError sets an error on the embed field, which is shown until it is
+// cleared with nil or the value pulled from the model changes.
+func (e *Embed) SetError(err error) {
+	e.err = err
+	e.setErr = setError{err: err, value: e.getValue()}
+}
+
+// validateField pulls the value from the model and validates it.
+func (e *Embed) validateField() {
+	e.pull()
+}
+
+// pull pulls the value from the model and validates it.
+func (e *Embed) pull() {
+	defer recoverAsError(!e.failFast, &e.err)
+	e.value = e.getValue()
+	e.err = e.setErr.get(e.value)
+	if e.err == nil {
+		e.err = e.validate(e.value)
+	}
+}
+
+// TabIndex sets the position of the embed field in its group's focus
+// order, see Group for how tab indices are ordered.
+func (e *Embed) TabIndex(index int) *Embed {
+	e.tabIndex = index
+	return e
+}
+
+// getTabIndex returns the tab index of the embed field.
+func (e *Embed) getTabIndex() int {
+	return e.tabIndex
+}
+
+// Focus focuses the embed field.
+func (e *Embed) Focus() tea.Cmd {
+	e.focused = true
+	if m, ok := e.model.(interface{ Focus() tea.Cmd }); ok {
+		return m.Focus()
+	}
+	return nil
+}
+
+// Blur blurs the embed field.
+func (e *Embed) Blur() tea.Cmd {
+	e.focused = false
+	e.pull()
+	switch m := e.model.(type) {
+	case interface{ Blur() tea.Cmd }:
+		return m.Blur()
+	case interface{ Blur() }:
+		m.Blur()
+	}
+	return nil
+}
+
+// enteringText returns whether the embed field takes typed text. Embedded
+// models are assumed to take text.
+func (e *Embed) enteringText() bool {
+	return true
+}
+
+// KeyBinds returns the help keybindings of the embed field, including those of
+// the model if it has any.
+func (e *Embed) KeyBinds() []key.Binding {
+	var binds []key.Binding
+	if m, ok := e.model.(interface{ ShortHelp() []key.Binding }); ok {
+		binds = m.ShortHelp()
+	}
+	return append(binds, e.keymap.Next, e.keymap.Prev)
+}
+
+// Init initializes the embed field.
+func (e *Embed) Init() tea.Cmd {
+	e.titleFunc.update(&e.title)
+	return e.model.Init()
+}
+
+// Update updates the embed field, forwarding messages to the model. Key
+// presses are only forwarded while the field is focused.
+func (e *Embed) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg = runUpdateHook(e.updateHook, msg); msg == nil {
+		return e, nil
+	}
+
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		if !e.focused {
+			return e, nil
+		}
+		e.err = nil
+		defer func() { e.setErr.keep(&e.err, e.getValue()) }()
+		switch {
+		case key.Matches(msg, e.keymap.Prev):
+			return e, prevField
+		case key.Matches(msg, e.keymap.Next):
+			e.pull()
+			if e.err != nil {
+				return e, nil
+			}
+			return e, nextField
+		}
+	}
+
+	var cmd tea.Cmd
+	e.model, cmd = e.model.Update(msg)
+	return e, cmd
+}
+
+// View renders the embed field.
+func (e *Embed) View() string {
+	styles := e.theme.fieldStyles(e.focused, e.err)
+
+	var sb strings.Builder
+	if e.title != "" {
+		sb.WriteString(styles.Title.Render(e.title))
+		if e.err != nil {
+			sb.WriteString(styles.ErrorIndicator.String())
+		}
+		sb.WriteString("\n")
+	}
+	if e.description != "" && e.descVisibility.show(e.focused) {
+		sb.WriteString(styles.Description.Render(e.descScroll.view(e.description)) + "\n")
+	}
+	sb.WriteString(e.model.View())
+	return fieldBase(styles, e.noPadding).Render(sb.String())
+}
+
+// Run runs the embed field.
+func (e *Embed) Run() error {
+	if e.accessible {
+		return e.runAccessible()
+	}
+	return Run(e)
+}
+
+// runAccessible runs the embed field in accessible mode. Arbitrary models
+// can't be prompted for, so the model is printed as it is and its value is
+// pulled as is.
+func (e *Embed) runAccessible() error {
+	fmt.Println(accessibleBlock(e.theme.Blurred.Base, e.theme.Focused.Title.Render(e.title), e.width))
+	fmt.Println(e.model.View())
+	fmt.Println()
+	e.pull()
+	return e.err
+}
+
+// WithTheme sets the theme of the embed field.
+func (e *Embed) WithTheme(theme *Theme) Field {
+	e.theme = theme
+	return e
+}
+
+// WithKeyMap sets the keymap of the embed field.
+func (e *Embed) WithKeyMap(k *KeyMap) Field {
+	e.keymap = &k.Embed
+	return e
+}
+
+// WithAccessible sets the accessible mode of the embed field.
+func (e *Embed) WithAccessible(accessible bool) Field {
+	e.accessible = accessible
+	return e
+}
+
+// WithStrings sets the user-facing strings of the embed field.
+func (e *Embed) WithStrings(strings *Strings) Field {
+	e.strings = strings
+	return e
+}
+
+// WithWidth sets the width of the embed field, passing it on to the model if
+// it has a SetWidth method.
+func (e *Embed) WithWidth(width int) Field {
+	e.width = width
+	if m, ok := e.model.(interface{ SetWidth(int) }); ok {
+		m.SetWidth(width - fieldBase(e.theme.Blurred, e.noPadding).GetHorizontalFrameSize())
+	}
+	return e
+}
+
+// WithoutPadding sets whether the embed field is rendered without the borders
+// and padding of its theme.
+func (e *Embed) WithoutPadding(v bool) Field {
+	e.noPadding = v
+	return e
+}
+
+// WithDescriptionVisibility sets when the description of the embed field is
+// shown.
+func (e *Embed) WithDescriptionVisibility(v DescriptionVisibility) Field {
+	e.descVisibility = v
+	return e
+}
+
+// WithDescriptionHeight limits the description of the embed field to the
+// given number of lines.
+func (e *Embed) WithDescriptionHeight(height int) Field {
+	e.descScroll.height = height
+	return e
+}
+
+// scrollDescription scrolls the description of the embed field.
+func (e *Embed) scrollDescription(delta int) {
+	e.descScroll.scroll(e.description, delta)
+}
+
+// WithUpdateHook sets the update hook of the embed field, see
+// Input.WithUpdateHook.
+func (e *Embed) WithUpdateHook(hook func(tea.Msg) tea.Msg) Field {
+	e.updateHook = hook
+	return e
+}
+
+// withRecover sets whether the embed field recovers from panics in its
+// callbacks.
+func (e *Embed) withRecover(v bool) {
+	e.failFast = !v
+}
+
+// GetKey returns the key of the field.
+func (e *Embed) GetKey() string {
+	return e.key
+}
+
+// GetValue returns the value pulled from the model.
+func (e *Embed) GetValue() any {
+	return e.value
+}
+
+// GetTitle returns the title of the field.
+func (e *Embed) GetTitle() string {
+	return e.title
+}
+
+// DisplayValue returns the value pulled from the model as text.
+func (e *Embed) DisplayValue() string {
+	if e.value == nil {
+		return ""
+	}
+	return fmt.Sprint(e.value)
+}
@@ -1,13 +1,15 @@
 package huh
 
 import (
+	"errors"
 	"fmt"
 	"strings"
+	"unicode"
 
+	"github.com/charmbracelet/bubbles/cursor"
 	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
-	"github.com/charmbracelet/huh/accessibility"
 	"github.com/charmbracelet/lipgloss"
 )
 
@@ -19,24 +21,50 @@ type Input struct {
 	// customization
 	title       string
 	description string
+	titleFunc   titleFunc
 	inline      bool
 	charlimit   int
+	secret      bool
+	envDefault  string
 
 	// error handling
-	validate func(string) error
-	err      error
+	validate   func(string) error
+	requiredIf func() bool
+	err        error
+	setErr     setError
+	warn       func(string) string
+	warning    string
+	transform  func(string) string
+	allowed    func(rune) bool
+	strength   func(string) int
 
 	// model
 	textinput textinput.Model
 
+	// history
+	history    HistoryStore
+	entries    []string
+	historyPos int
+	draft      string
+
 	// state
 	focused bool
 
 	// options
-	width      int
-	accessible bool
-	theme      *Theme
-	keymap     *InputKeyMap
+	cursorModeSet  bool
+	width          int
+	tabIndex       int
+	accessible     bool
+	updateHook     func(tea.Msg) tea.Msg
+	failFast       bool
+	validationMode ValidationMode
+	noPadding      bool
+	descVisibility DescriptionVisibility
+	descScroll     descriptionScroll
+	theme          *Theme
+	keymap         *InputKeyMap
+	remaps         []keyRemap
+	strings        *Strings
 }
 
 // NewInput returns a new input field.
@@ -47,9 +75,11 @@ func NewInput() *Input {
 		value:     new(string),
 		textinput: input,
 		validate:  func(string) error { return nil },
+		warn:      func(string) string { return "" },
+		strings:   DefaultStrings(),
 	}
 
-	return i
+	return withDefaults(i)
 }
 
 // Value sets the value of the input field.
@@ -59,6 +89,20 @@ func (i *Input) Value(value *string) *Input {
 	return i
 }
 
+// setValue sets the value of the input field.
+func (i *Input) setValue(value any) error {
+	if i.secret && value == Redacted {
+		return nil
+	}
+	v, err := convert[string](value)
+	if err != nil {
+		return err
+	}
+	*i.value = v
+	i.textinput.SetValue(v)
+	return nil
+}
+
 // Key sets the key of the input field.
 func (i *Input) Key(key string) *Input {
 	i.key = key
@@ -71,6 +115,21 @@ func (i *Input) Title(title string) *Input {
 	return i
 }
 
+// TitleFunc sets a function computing the title of the input field, which
+// is recomputed when the value bindings points to changes.
+func (i *Input) TitleFunc(f func() string, bindings any) *Input {
+	i.titleFunc = titleFunc{f: f, bindings: bindings}
+	i.titleFunc.update(&i.title)
+	return i
+}
+
+// updateBindings recomputes the title of the input field if the value of
+// its bindings changed.
+func (i *Input) updateBindings() tea.Cmd {
+	i.titleFunc.update(&i.title)
+	return nil
+}
+
 // Description sets the description of the input field.
 func (i *Input) Description(description string) *Input {
 	i.description = description
@@ -83,9 +142,23 @@ func (i *Input) Prompt(prompt string) *Input {
 	return i
 }
 
+// Secret sets whether the value of the input field is a secret, such as a
+// password or token. Secret values are redacted from everything the form
+// outputs, see Redacted, while the bound value still holds the real value.
+func (i *Input) Secret(secret bool) *Input {
+	i.secret = secret
+	return i
+}
+
+// isSecret returns whether the value of the input field is a secret.
+func (i *Input) isSecret() bool {
+	return i.secret
+}
+
 // CharLimit sets the character limit of the input field.
 func (i *Input) CharLimit(charlimit int) *Input {
 	i.charlimit = charlimit
+	i.textinput.CharLimit = charlimit
 	return i
 }
 
@@ -99,6 +172,16 @@ func (i *Input) Password(password bool) *Input {
 	return i
 }
 
+// DefaultFromEnv pre-fills the input field from the named environment
+// variable when the form starts.
+//
+// A set, non-empty variable takes precedence over the value the field was
+// given with Value. Otherwise that value is kept, or the field starts empty.
+func (i *Input) DefaultFromEnv(name string) *Input {
+	i.envDefault = name
+	return i
+}
+
 // Placeholder sets the placeholder of the text input.
 func (i *Input) Placeholder(str string) *Input {
 	i.textinput.Placeholder = str
@@ -111,6 +194,61 @@ func (i *Input) Inline(inline bool) *Input {
 	return i
 }
 
+// Transform sets a function that transforms the input's value as it changes,
+// for example to force upper case or strip whitespace.
+//
+// The transform runs on every change after the keystroke has been applied and
+// limited by CharLimit, and its result is limited by CharLimit again. It
+// always sees the real value, even when the input is a password. Validation
+// runs on the transformed value and the cursor keeps its distance from the end
+// of the value.
+func (i *Input) Transform(transform func(string) string) *Input {
+	i.transform = transform
+	return i
+}
+
+// AllowedRunes sets which characters can be typed into the input field.
+// Keystrokes and pasted characters that aren't allowed are dropped before they
+// reach the input, and so before Transform, rather than failing validation
+// later. In accessible mode, answers with characters that aren't allowed are
+// asked again.
+func (i *Input) AllowedRunes(allowed func(rune) bool) *Input {
+	i.allowed = allowed
+	return i
+}
+
+// AllowAlphanumeric allows letters and digits, see Input.AllowedRunes.
+func AllowAlphanumeric(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// AllowDigits allows the digits 0 to 9, see Input.AllowedRunes.
+func AllowDigits(r rune) bool {
+	return r >= '0' && r <= '9'
+}
+
+// AllowHostname allows the characters of hostnames: ASCII letters, digits,
+// hyphens and dots, see Input.AllowedRunes.
+func AllowHostname(r rune) bool {
+	return r < unicode.MaxASCII && (AllowAlphanumeric(r) || r == '-' || r == '.')
+}
+
+// allowRunes drops the characters of a keystroke that aren't allowed,
+// returning false if none are left.
+func (i *Input) allowRunes(msg tea.KeyMsg) (tea.KeyMsg, bool) {
+	if i.allowed == nil || (msg.Type != tea.KeyRunes && msg.Type != tea.KeySpace) {
+		return msg, true
+	}
+	runes := make([]rune, 0, len(msg.Runes))
+	for _, r := range msg.Runes {
+		if i.allowed(r) {
+			runes = append(runes, r)
+		}
+	}
+	msg.Runes = runes
+	return msg, len(runes) > 0
+}
+
 // Validate sets the validation function of the input field.
 func (i *Input) Validate(validate func(string) error) *Input {
 	i.validate = validate
@@ -122,9 +260,222 @@ func (i *Input) Error() error {
 	return i.err
 }
 
+// Warn sets the warning function of the input field.
+//
+// Warnings are shown like errors but don't prevent the user from moving on,
+// which is useful for unusual but allowed values. A warning is returned as a
+// non-empty string.
+func (i *Input) Warn(warn func(string) string) *Input {
+	i.warn = warn
+	return i
+}
+
+// Warning returns the warning of the input field.
+func (i *Input) Warning() string {
+	return i.warning
+}
+
+// CursorMode sets whether the cursor of the input field blinks, stays static
+// or is hidden. Only the focused field shows a cursor.
+func (i *Input) CursorMode(mode cursor.Mode) *Input {
+	i.cursorModeSet = true
+	i.textinput.Cursor.SetMode(mode)
+	return i
+}
+
+// withCursorMode sets the cursor mode unless one was set on the field itself.
+func (i *Input) withCursorMode(mode cursor.Mode) {
+	if i.cursorModeSet {
+		return
+	}
+	i.textinput.Cursor.SetMode(mode)
+}
+
+// WithStrengthMeter shows a strength meter under the input field, such as
+// for passwords, scored by the given function as it is typed.
+//
+// Scores range from 0 (very weak) to 4 (strong). The meter doesn't validate the
+// value, use Validate to enforce a minimum score.
+func (i *Input) WithStrengthMeter(score func(string) int) *Input {
+	i.strength = score
+	return i
+}
+
+// maxStrength is the highest score of a strength meter.
+const maxStrength = 4
+
+// strengthScore returns the strength score of the value and its label.
+func (i *Input) strengthScore(value string) (int, string) {
+	score := clamp(i.strength(value), 0, maxStrength)
+	label := ""
+	if score < len(i.strings.Strength) {
+		label = i.strings.Strength[score]
+	}
+	return score, label
+}
+
+// strengthView renders the strength meter of the input field.
+func (i *Input) strengthView(styles FieldStyles) string {
+	score, label := i.strengthScore(i.textinput.Value())
+	style := styles.StrengthWeak
+	switch {
+	case score >= 3:
+		style = styles.StrengthStrong
+	case score == 2:
+		style = styles.StrengthFair
+	}
+
+	var sb strings.Builder
+	for n := 0; n < maxStrength; n++ {
+		if n < score {
+			sb.WriteString(style.Render("▰"))
+		} else {
+			sb.WriteString(styles.Description.Render("▱"))
+		}
+	}
+	sb.WriteString(" " + style.Render(label))
+	return sb.String()
+}
+
+// RemapKey replaces the key from with the keys to in the bindings of the
+// input field, on top of the keymap of its form, see KeyMap.Remap.
+func (i *Input) RemapKey(from string, to ...string) *Input {
+	i.remaps = append(i.remaps, keyRemap{from, to})
+	if i.keymap != nil {
+		keymap := *i.keymap
+		remapKeys(keymap.bindings(), i.remaps[len(i.remaps)-1:])
+		i.keymap = &keymap
+	}
+	return i
+}
+
+// RequiredIf sets a predicate under which the input field can't be left
+// empty.
+//
+// The predicate is evaluated whenever the field is validated, which is when
+// the user moves on from it, so it can depend on the answers to previous
+// fields. Fields in hidden groups aren't validated.
+func (i *Input) RequiredIf(required func() bool) *Input {
+	i.requiredIf = required
+	return i
+}
+
+// missing reports whether the input field is required but still empty.
+func (i *Input) missing() bool {
+	return i.textinput.Value() == "" && i.requiredIf != nil && i.requiredIf()
+}
+
+// check checks whether the value is required before validating it.
+func (i *Input) check(value string) error {
+	if value == "" && i.requiredIf != nil && i.requiredIf() {
+		return errors.New(i.strings.Required)
+	}
+	return i.validate(value)
+}
+
+// SetError sets an error on the input field, which is shown until it is
+// cleared with nil or the value changes.
+func (i *Input) SetError(err error) {
+	i.err = err
+	i.setErr = setError{err: err, value: i.textinput.Value()}
+}
+
+// validateField stores and validates the value of the input field.
+func (i *Input) validateField() {
+	*i.value = i.textinput.Value()
+	i.runValidation(*i.value)
+}
+
+// runValidation validates the value, setting the error and warning of the
+// input field.
+func (i *Input) runValidation(value string) {
+	if i.validationMode == ValidationOnSubmit {
+		return
+	}
+	defer recoverAsError(!i.failFast, &i.err)
+	i.err = i.setErr.get(value)
+	if i.err == nil {
+		i.err = i.check(value)
+	}
+	i.warning = i.warn(value)
+}
+
+// TabIndex sets the position of the input field in its group's focus
+// order, see Group for how tab indices are ordered.
+func (i *Input) TabIndex(index int) *Input {
+	i.tabIndex = index
+	return i
+}
+
+// WithHistory sets the store of the input field's history. Up and down recall
+// the previous values entered in the field when the cursor is at the start or
+// end of the value, and each value the field is submitted with is saved to the
+// store. Secret fields don't keep history.
+func (i *Input) WithHistory(store HistoryStore) *Input {
+	i.history = store
+	return i
+}
+
+// recallsHistory returns whether the input field recalls history.
+func (i *Input) recallsHistory() bool {
+	return i.history != nil && !i.secret
+}
+
+// loadHistory loads the entries of the history and starts recalling from the
+// newest. Entries that fail to load are left out.
+func (i *Input) loadHistory() {
+	if !i.recallsHistory() {
+		return
+	}
+	i.entries, _ = i.history.Load()
+	i.historyPos = len(i.entries)
+}
+
+// saveHistory saves a submitted value to the history. Empty values aren't
+// saved.
+func (i *Input) saveHistory(value string) {
+	if !i.recallsHistory() || value == "" {
+		return
+	}
+	i.entries = addEntry(i.entries, value)
+	i.historyPos = len(i.entries)
+	_ = i.history.Save(i.entries)
+}
+
+// recall replaces the value with the history entry delta entries away, or with
+// the value being typed when moving past the newest entry.
+func (i *Input) recall(delta int) {
+	pos := i.textinput.Position()
+	if pos != 0 && pos != len([]rune(i.textinput.Value())) {
+		return
+	}
+	next := clamp(i.historyPos+delta, 0, len(i.entries))
+	if next == i.historyPos {
+		return
+	}
+	if i.historyPos == len(i.entries) {
+		i.draft = i.textinput.Value()
+	}
+	i.historyPos = next
+
+	value := i.draft
+	if next < len(i.entries) {
+		value = i.entries[next]
+	}
+	i.textinput.SetValue(value)
+	i.textinput.CursorEnd()
+	*i.value = value
+}
+
+// getTabIndex returns the tab index of the input field.
+func (i *Input) getTabIndex() int {
+	return i.tabIndex
+}
+
 // Focus focuses the input field.
 func (i *Input) Focus() tea.Cmd {
 	i.focused = true
+	i.loadHistory()
 	return i.textinput.Focus()
 }
 
@@ -133,48 +484,88 @@ func (i *Input) Blur() tea.Cmd {
 	i.focused = false
 	*i.value = i.textinput.Value()
 	i.textinput.Blur()
-	i.err = i.validate(*i.value)
+	i.runValidation(*i.value)
 	return nil
 }
 
+// enteringText returns whether the input field takes typed text. Input fields
+// always take text.
+func (i *Input) enteringText() bool {
+	return true
+}
+
 // KeyBinds returns the help message for the input field.
 func (i *Input) KeyBinds() []key.Binding {
+	if i.recallsHistory() && len(i.entries) > 0 {
+		return []key.Binding{i.keymap.HistoryPrev, i.keymap.HistoryNext, i.keymap.Next, i.keymap.Prev}
+	}
 	return []key.Binding{i.keymap.Next, i.keymap.Prev}
 }
 
 // Init initializes the input field.
 func (i *Input) Init() tea.Cmd {
+	i.titleFunc.update(&i.title)
+	if value, ok := lookupEnv(i.envDefault); ok {
+		*i.value = value
+		i.textinput.SetValue(value)
+	}
 	i.textinput.Blur()
 	return nil
 }
 
 // Update updates the input field.
 func (i *Input) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg = runUpdateHook(i.updateHook, msg); msg == nil {
+		return i, nil
+	}
+
 	var cmds []tea.Cmd
 	var cmd tea.Cmd
 
+	if keyMsg, ok := msg.(tea.KeyMsg); ok {
+		if msg, ok = i.allowRunes(keyMsg); !ok {
+			return i, nil
+		}
+	}
+
+	value, pos := i.textinput.Value(), i.textinput.Position()
 	i.textinput, cmd = i.textinput.Update(msg)
 	cmds = append(cmds, cmd)
+	if msg, ok := msg.(tea.KeyMsg); ok && i.textinput.Focused() {
+		i.stepGraphemes(msg, value, pos)
+	}
+	i.applyTransform()
 	*i.value = i.textinput.Value()
 
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
-		i.err = nil
+		i.err = i.setErr.get(*i.value)
+		i.warning = ""
+		if i.validationMode == ValidationInline {
+			i.runValidation(i.textinput.Value())
+		}
 
 		switch {
+		case typedText(msg):
+			// Typed text is never taken by a binding, see KeyMap.Conflicts.
+		case i.recallsHistory() && key.Matches(msg, i.keymap.HistoryPrev):
+			i.recall(-1)
+		case i.recallsHistory() && key.Matches(msg, i.keymap.HistoryNext):
+			i.recall(1)
 		case key.Matches(msg, i.keymap.Prev):
 			value := i.textinput.Value()
-			i.err = i.validate(value)
+			i.runValidation(value)
 			if i.err != nil {
 				return i, nil
 			}
 			cmds = append(cmds, prevField)
 		case key.Matches(msg, i.keymap.Next):
 			value := i.textinput.Value()
-			i.err = i.validate(value)
+			i.runValidation(value)
 			if i.err != nil {
 				return i, nil
 			}
+			i.saveHistory(value)
 			cmds = append(cmds, nextField)
 		}
 	}
@@ -182,17 +573,55 @@ func (i *Input) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return i, tea.Batch(cmds...)
 }
 
+// stepGraphemes widens the single rune steps of the text input's cursor
+// movement and deletion to whole grapheme clusters, so that emoji and
+// combining sequences aren't split. It's given the value and position of the
+// cursor before the key.
+func (i *Input) stepGraphemes(msg tea.KeyMsg, value string, pos int) {
+	keymap := i.textinput.KeyMap
+	runes := []rune(value)
+	switch {
+	case key.Matches(msg, keymap.DeleteCharacterBackward):
+		if start := graphemeBefore(value, pos); start < pos-1 {
+			i.textinput.SetValue(string(runes[:start]) + string(runes[pos:]))
+			i.textinput.SetCursor(start)
+		}
+	case key.Matches(msg, keymap.CharacterBackward):
+		i.textinput.SetCursor(graphemeBefore(value, pos))
+	case key.Matches(msg, keymap.CharacterForward):
+		i.textinput.SetCursor(graphemeAfter(value, pos))
+	case key.Matches(msg, keymap.DeleteCharacterForward):
+		if end := graphemeAfter(value, pos); end > pos+1 {
+			i.textinput.SetValue(string(runes[:pos]) + string(runes[end:]))
+			i.textinput.SetCursor(pos)
+		}
+	}
+}
+
+// applyTransform transforms the value of the text input, preserving the
+// cursor's distance from the end of the value.
+func (i *Input) applyTransform() {
+	if i.transform == nil {
+		return
+	}
+	value := i.textinput.Value()
+	transformed := i.transform(value)
+	if transformed == value {
+		return
+	}
+	fromEnd := len([]rune(value)) - i.textinput.Position()
+	i.textinput.SetValue(transformed)
+	i.textinput.SetCursor(len([]rune(i.textinput.Value())) - fromEnd)
+}
+
 // View renders the input field.
 func (i *Input) View() string {
-	styles := i.theme.Blurred
-	if i.focused {
-		styles = i.theme.Focused
-	}
+	styles := i.theme.fieldStyles(i.focused, i.err)
 
 	// NB: since the method is on a pointer receiver these are being mutated.
 	// Because this runs on every render this shouldn't matter in practice,
 	// however.
-	i.textinput.PlaceholderStyle = styles.TextInput.Placeholder
+	i.textinput.PlaceholderStyle = styles.placeholder()
 	i.textinput.PromptStyle = styles.TextInput.Prompt
 	i.textinput.Cursor.Style = styles.TextInput.Cursor
 	i.textinput.TextStyle = styles.TextInput.Text
@@ -200,20 +629,28 @@ func (i *Input) View() string {
 	var sb strings.Builder
 	if i.title != "" {
 		sb.WriteString(styles.Title.Render(i.title))
+		if i.err != nil {
+			sb.WriteString(styles.ErrorIndicator.String())
+		} else if i.warning != "" {
+			sb.WriteString(styles.WarningIndicator.String())
+		}
 		if !i.inline {
 			sb.WriteString("\n")
 		}
 	}
-	if i.description != "" {
-		sb.WriteString(styles.Description.Render(i.description))
+	if i.description != "" && i.descVisibility.show(i.focused) {
+		sb.WriteString(styles.Description.Render(i.descScroll.view(i.description)))
 		if !i.inline {
 			sb.WriteString("\n")
 		}
 	}
 
 	sb.WriteString(i.textinput.View())
+	if i.strength != nil {
+		sb.WriteString("\n" + i.strengthView(styles))
+	}
 
-	return styles.Base.Render(sb.String())
+	return fieldBase(styles, i.noPadding).Render(sb.String())
 }
 
 // Run runs the input field in accessible mode.
@@ -231,16 +668,36 @@ func (i *Input) run() error {
 
 // runAccessible runs the input field in accessible mode.
 func (i *Input) runAccessible() error {
-	fmt.Println(i.theme.Blurred.Base.Render(i.theme.Focused.Title.Render(i.title)))
+	fmt.Println(accessibleBlock(i.theme.Blurred.Base, i.theme.Focused.Title.Render(i.title), i.width))
 	fmt.Println()
-	*i.value = accessibility.PromptString("Input: ", i.validate)
-	fmt.Println(i.theme.Focused.SelectedOption.Render("Input: " + *i.value + "\n"))
+	value, err := promptString(i.strings, i.strings.Input, func(value string) error {
+		for _, r := range value {
+			if i.allowed != nil && !i.allowed(r) {
+				return errors.New(i.strings.InvalidInput)
+			}
+		}
+		return i.check(value)
+	})
+	if err != nil {
+		return err
+	}
+	*i.value = value
+	fmt.Println(i.theme.Focused.SelectedOption.Render(i.strings.Input + i.DisplayValue() + "\n"))
+	if i.strength != nil {
+		_, label := i.strengthScore(value)
+		fmt.Println(i.strings.StrengthPrefix + label + "\n")
+	}
 	return nil
 }
 
 // WithKeyMap sets the keymap on an input field.
 func (i *Input) WithKeyMap(k *KeyMap) Field {
 	i.keymap = &k.Input
+	if len(i.remaps) > 0 {
+		keymap := k.Input
+		remapKeys(keymap.bindings(), i.remaps)
+		i.keymap = &keymap
+	}
 	return i
 }
 
@@ -256,10 +713,16 @@ func (i *Input) WithTheme(theme *Theme) Field {
 	return i
 }
 
+// WithStrings sets the user-facing strings of the input field.
+func (i *Input) WithStrings(strings *Strings) Field {
+	i.strings = strings
+	return i
+}
+
 // WithWidth sets the width of the input field.
 func (i *Input) WithWidth(width int) Field {
 	i.width = width
-	frameSize := i.theme.Blurred.Base.GetHorizontalFrameSize()
+	frameSize := fieldBase(i.theme.Blurred, i.noPadding).GetHorizontalFrameSize()
 	promptWidth := lipgloss.Width(i.textinput.PromptStyle.Render(i.textinput.Prompt))
 	titleWidth := lipgloss.Width(i.theme.Focused.Title.Render(i.title))
 	i.textinput.Width = width - frameSize - promptWidth - 1
@@ -269,6 +732,54 @@ func (i *Input) WithWidth(width int) Field {
 	return i
 }
 
+// WithoutPadding sets whether the input field is rendered without the borders
+// and padding of the Base style of its theme, which is useful when the field
+// is already framed, for example by a bordered pane. The theme itself isn't
+// changed.
+func (i *Input) WithoutPadding(v bool) Field {
+	i.noPadding = v
+	return i
+}
+
+// WithDescriptionVisibility sets when the description of the input field is
+// shown.
+func (i *Input) WithDescriptionVisibility(v DescriptionVisibility) Field {
+	i.descVisibility = v
+	return i
+}
+
+// WithDescriptionHeight limits the description of the input field to the
+// given number of lines.
+func (i *Input) WithDescriptionHeight(height int) Field {
+	i.descScroll.height = height
+	return i
+}
+
+// scrollDescription scrolls the description of the input field.
+func (i *Input) scrollDescription(delta int) {
+	i.descScroll.scroll(i.description, delta)
+}
+
+// WithUpdateHook sets a hook that is called with each message before the
+// input field updates. The hook returns the message for the field to update
+// with, which can be a different message, or nil to consume it so that the
+// field doesn't update. A nil hook leaves messages as they are.
+func (i *Input) WithUpdateHook(hook func(tea.Msg) tea.Msg) Field {
+	i.updateHook = hook
+	return i
+}
+
+// withRecover sets whether the input field recovers from panics in its
+// callbacks.
+func (i *Input) withRecover(v bool) {
+	i.failFast = !v
+}
+
+// withValidationMode sets when the input field validates its value.
+func (i *Input) withValidationMode(mode ValidationMode) {
+	i.validationMode = mode
+}
+
 // GetKey returns the key of the field.
 func (i *Input) GetKey() string {
 	return i.key
@@ -278,3 +789,20 @@ func (i *Input) GetKey() string {
 func (i *Input) GetValue() any {
 	return *i.value
 }
+
+// GetTitle returns the title of the field.
+func (i *Input) GetTitle() string {
+	return i.title
+}
+
+// DisplayValue returns the value of the field as it is displayed, masked when
+// the input is a password and redacted when it is a secret.
+func (i *Input) DisplayValue() string {
+	if i.secret {
+		return Redacted
+	}
+	if i.textinput.EchoMode == textinput.EchoPassword {
+		return strings.Repeat(string(i.textinput.EchoCharacter), len([]rune(*i.value)))
+	}
+	return *i.value
+}
@@ -1,49 +1,67 @@
 package huh
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
 	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
-	"github.com/charmbracelet/huh/accessibility"
 	"github.com/charmbracelet/lipgloss"
 )
 
 // MultiSelect is a form multi-select field.
 type MultiSelect[T any] struct {
 	value *[]T
+	set   *valueSet[T]
 	key   string
 
 	// customization
 	title       string
 	description string
+	titleFunc   titleFunc
 	options     []Option[T]
 	filterable  bool
 	limit       int
 
 	// error handling
-	validate func([]T) error
-	err      error
+	validate   func([]T) error
+	requiredIf func() bool
+	err        error
+	setErr     setError
+	warn       func([]T) string
+	warning    string
 
 	// state
 	cursor  int
+	offset  int
 	focused bool
 
 	// options
-	width      int
-	accessible bool
-	theme      *Theme
-	keymap     *MultiSelectKeyMap
+	width          int
+	height         int
+	tabIndex       int
+	accessible     bool
+	updateHook     func(tea.Msg) tea.Msg
+	failFast       bool
+	validationMode ValidationMode
+	noPadding      bool
+	descVisibility DescriptionVisibility
+	descScroll     descriptionScroll
+	theme          *Theme
+	keymap         *MultiSelectKeyMap
+	strings        *Strings
 }
 
 // NewMultiSelect returns a new multi-select field.
 func NewMultiSelect[T any]() *MultiSelect[T] {
-	return &MultiSelect[T]{
+	return withDefaults(&MultiSelect[T]{
 		options:  []Option[T]{},
 		value:    new([]T),
 		validate: func([]T) error { return nil },
-	}
+		warn:     func([]T) string { return "" },
+		strings:  DefaultStrings(),
+	})
 }
 
 // Value sets the value of the multi-select field.
@@ -52,6 +70,54 @@ func (m *MultiSelect[T]) Value(value *[]T) *MultiSelect[T] {
 	return m
 }
 
+// valueSet is the binding of a multi-select field to a set of values, see
+// ValueSet. It is made of functions because the values of multi-select fields
+// aren't necessarily comparable.
+type valueSet[T any] struct {
+	has   func(T) bool
+	len   func() int
+	store func([]T)
+}
+
+// ValueSet binds the multi-select field to a set of values, as an alternative
+// to Value for comparable values. Options are checked by looking their values
+// up in the set rather than by scanning a slice, which keeps fields with
+// hundreds of options and selections fast.
+//
+// Sets don't preserve order. The values are also stored in the slice bound
+// with Value, and returned by GetValue, in the order of the options.
+func ValueSet[T comparable](m *MultiSelect[T], set *map[T]struct{}) *MultiSelect[T] {
+	m.set = &valueSet[T]{
+		has: func(v T) bool {
+			_, ok := (*set)[v]
+			return ok
+		},
+		len: func() int { return len(*set) },
+		store: func(values []T) {
+			*set = make(map[T]struct{}, len(values))
+			for _, v := range values {
+				(*set)[v] = struct{}{}
+			}
+		},
+	}
+	return m
+}
+
+// setValue sets the value of the multi-select field, selecting the matching
+// options.
+func (m *MultiSelect[T]) setValue(value any) error {
+	v, err := convert[[]T](value)
+	if err != nil {
+		return err
+	}
+	*m.value = v
+	if m.set != nil {
+		m.set.store(v)
+	}
+	m.selectOptions()
+	return nil
+}
+
 // Key sets the key of the select field which can be used to retrieve the value
 // after submission.
 func (m *MultiSelect[T]) Key(key string) *MultiSelect[T] {
@@ -65,6 +131,21 @@ func (m *MultiSelect[T]) Title(title string) *MultiSelect[T] {
 	return m
 }
 
+// TitleFunc sets a function computing the title of the multi-select field, which
+// is recomputed when the value bindings points to changes.
+func (m *MultiSelect[T]) TitleFunc(f func() string, bindings any) *MultiSelect[T] {
+	m.titleFunc = titleFunc{f: f, bindings: bindings}
+	m.titleFunc.update(&m.title)
+	return m
+}
+
+// updateBindings recomputes the title of the multi-select field if the value of
+// its bindings changed.
+func (m *MultiSelect[T]) updateBindings() tea.Cmd {
+	m.titleFunc.update(&m.title)
+	return nil
+}
+
 // Description sets the description of the multi-select field.
 func (m *MultiSelect[T]) Description(description string) *MultiSelect[T] {
 	m.description = description
@@ -80,12 +161,43 @@ func (m *MultiSelect[T]) Options(options ...Option[T]) *MultiSelect[T] {
 	return m
 }
 
+// optionValues returns the values of the options of the multi-select field.
+func (m *MultiSelect[T]) optionValues() []string {
+	return jsonValues(m.options)
+}
+
 // Filterable sets the multi-select field as filterable.
 func (m *MultiSelect[T]) Filterable(filterable bool) *MultiSelect[T] {
 	m.filterable = filterable
 	return m
 }
 
+// Height sets the number of options to show at once.
+//
+// When there are more options than fit, the options scroll to keep the cursor
+// in view, with arrows above and below them while there are more options that
+// way. A height of zero shows all options.
+func (m *MultiSelect[T]) Height(height int) *MultiSelect[T] {
+	m.height = height
+	return m
+}
+
+// scroll updates the offset of the visible options so that the cursor stays
+// in view.
+func (m *MultiSelect[T]) scroll() {
+	if m.height <= 0 {
+		m.offset = 0
+		return
+	}
+	if m.cursor < m.offset {
+		m.offset = m.cursor
+	}
+	if m.cursor >= m.offset+m.height {
+		m.offset = m.cursor - m.height + 1
+	}
+	m.offset = clamp(m.offset, 0, max(len(m.options)-m.height, 0))
+}
+
 // Limit sets the limit of the multi-select field.
 func (m *MultiSelect[T]) Limit(limit int) *MultiSelect[T] {
 	m.limit = limit
@@ -103,9 +215,89 @@ func (m *MultiSelect[T]) Error() error {
 	return m.err
 }
 
+// Warn sets the warning function of the multi-select field.
+//
+// Warnings are shown like errors but don't prevent the user from moving on,
+// which is useful for unusual but allowed values. A warning is returned as a
+// non-empty string.
+func (m *MultiSelect[T]) Warn(warn func([]T) string) *MultiSelect[T] {
+	m.warn = warn
+	return m
+}
+
+// Warning returns the warning of the multi-select field.
+func (m *MultiSelect[T]) Warning() string {
+	return m.warning
+}
+
+// RequiredIf sets a predicate under which the multi-select field can't be left
+// without a selection.
+//
+// The predicate is evaluated whenever the field is validated, which is when
+// the user moves on from it, so it can depend on the answers to previous
+// fields. Fields in hidden groups aren't validated.
+func (m *MultiSelect[T]) RequiredIf(required func() bool) *MultiSelect[T] {
+	m.requiredIf = required
+	return m
+}
+
+// missing reports whether the multi-select field is required but has no
+// selected options.
+func (m *MultiSelect[T]) missing() bool {
+	return m.numSelected() == 0 && m.requiredIf != nil && m.requiredIf()
+}
+
+// check checks whether the value is required before validating it.
+func (m *MultiSelect[T]) check(value []T) error {
+	if len(value) == 0 && m.requiredIf != nil && m.requiredIf() {
+		return errors.New(m.strings.Required)
+	}
+	return m.validate(value)
+}
+
+// SetError sets an error on the multi-select field, which is shown until it is
+// cleared with nil or the value changes.
+func (m *MultiSelect[T]) SetError(err error) {
+	m.err = err
+	m.setErr = setError{err: err, value: *m.value}
+}
+
+// validateField stores and validates the selected options of the
+// multi-select field.
+func (m *MultiSelect[T]) validateField() {
+	m.finalize()
+}
+
+// runValidation validates the value, setting the error and warning of the
+// multi-select field.
+func (m *MultiSelect[T]) runValidation(value []T) {
+	if m.validationMode == ValidationOnSubmit {
+		return
+	}
+	defer recoverAsError(!m.failFast, &m.err)
+	m.err = m.setErr.get(value)
+	if m.err == nil {
+		m.err = m.check(value)
+	}
+	m.warning = m.warn(value)
+}
+
+// TabIndex sets the position of the multi-select field in its group's focus
+// order, see Group for how tab indices are ordered.
+func (m *MultiSelect[T]) TabIndex(index int) *MultiSelect[T] {
+	m.tabIndex = index
+	return m
+}
+
+// getTabIndex returns the tab index of the multi-select field.
+func (m *MultiSelect[T]) getTabIndex() int {
+	return m.tabIndex
+}
+
 // Focus focuses the multi-select field.
 func (m *MultiSelect[T]) Focus() tea.Cmd {
 	m.focused = true
+	m.selectOptions()
 	return nil
 }
 
@@ -117,20 +309,28 @@ func (m *MultiSelect[T]) Blur() tea.Cmd {
 
 // KeyBinds returns the help message for the multi-select field.
 func (m *MultiSelect[T]) KeyBinds() []key.Binding {
-	return []key.Binding{m.keymap.Toggle, m.keymap.Up, m.keymap.Down, m.keymap.Next, m.keymap.Prev}
+	return []key.Binding{m.keymap.Toggle, m.keymap.Up, m.keymap.Down, m.keymap.Next, m.keymap.Prev, m.keymap.ToggleAll}
 }
 
 // Init initializes the multi-select field.
 func (m *MultiSelect[T]) Init() tea.Cmd {
+	m.titleFunc.update(&m.title)
+	m.selectOptions()
 	return nil
 }
 
 // Update updates the multi-select field.
 func (m *MultiSelect[T]) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg = runUpdateHook(m.updateHook, msg); msg == nil {
+		return m, nil
+	}
+
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
 
 		m.err = nil
+		defer func() { m.setErr.keep(&m.err, *m.value) }()
+		m.warning = ""
 
 		switch {
 		case key.Matches(msg, m.keymap.Up):
@@ -142,6 +342,8 @@ func (m *MultiSelect[T]) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				break
 			}
 			m.options[m.cursor].selected = !m.options[m.cursor].selected
+		case key.Matches(msg, m.keymap.ToggleAll):
+			m.toggleAll()
 		case key.Matches(msg, m.keymap.Prev):
 			m.finalize()
 			if m.err != nil {
@@ -157,9 +359,52 @@ func (m *MultiSelect[T]) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 	}
 
+	m.scroll()
 	return m, nil
 }
 
+// toggleAll selects all the options, or clears them if they are all selected
+// or the limit is reached.
+func (m *MultiSelect[T]) toggleAll() {
+	full := m.limit > 0 && m.numSelected() >= m.limit
+	if !full {
+		full = true
+		for _, option := range m.options {
+			if !option.hidden() && !option.selected {
+				full = false
+				break
+			}
+		}
+	}
+	if full {
+		m.clearAll()
+	} else {
+		m.selectAll()
+	}
+}
+
+// selectAll selects the options in order, up to the limit. Hidden options are
+// skipped.
+func (m *MultiSelect[T]) selectAll() {
+	for i := range m.options {
+		if m.limit > 0 && m.numSelected() >= m.limit {
+			return
+		}
+		if !m.options[i].hidden() {
+			m.options[i].selected = true
+		}
+	}
+}
+
+// clearAll clears the selection of the options that aren't hidden.
+func (m *MultiSelect[T]) clearAll() {
+	for i := range m.options {
+		if !m.options[i].hidden() {
+			m.options[i].selected = false
+		}
+	}
+}
+
 func (m *MultiSelect[T]) numSelected() int {
 	var count int
 	for _, o := range m.options {
@@ -170,6 +415,35 @@ func (m *MultiSelect[T]) numSelected() int {
 	return count
 }
 
+// selectOptions marks the options found in the bound value as selected so
+// that editing starts from the existing selection. Values that don't match any
+// option are ignored and duplicates select their option only once.
+//
+// If the bound value is empty the options keep their own selected state.
+func (m *MultiSelect[T]) selectOptions() {
+	if m.set != nil {
+		if m.set.len() <= 0 {
+			return
+		}
+		for i, option := range m.options {
+			m.options[i].selected = m.set.has(option.Value)
+		}
+		return
+	}
+	if len(*m.value) <= 0 {
+		return
+	}
+	for i, option := range m.options {
+		m.options[i].selected = false
+		for _, v := range *m.value {
+			if equal(option.Value, v) {
+				m.options[i].selected = true
+				break
+			}
+		}
+	}
+}
+
 func (m *MultiSelect[T]) finalize() {
 	*m.value = make([]T, 0)
 	for _, option := range m.options {
@@ -177,27 +451,36 @@ func (m *MultiSelect[T]) finalize() {
 			*m.value = append(*m.value, option.Value)
 		}
 	}
-	m.err = m.validate(*m.value)
+	if m.set != nil {
+		m.set.store(*m.value)
+	}
+	m.runValidation(*m.value)
 }
 
 // View renders the multi-select field.
 func (m *MultiSelect[T]) View() string {
-	styles := m.theme.Blurred
-	if m.focused {
-		styles = m.theme.Focused
-	}
+	styles := m.theme.fieldStyles(m.focused, m.err)
 
 	var sb strings.Builder
 	sb.WriteString(styles.Title.Render(m.title))
 	if m.err != nil {
 		sb.WriteString(styles.ErrorIndicator.String())
+	} else if m.warning != "" {
+		sb.WriteString(styles.WarningIndicator.String())
 	}
 	sb.WriteString("\n")
-	if m.description != "" {
-		sb.WriteString(styles.Description.Render(m.description) + "\n")
+	if m.description != "" && m.descVisibility.show(m.focused) {
+		sb.WriteString(styles.Description.Render(m.descScroll.view(m.description)) + "\n")
 	}
 	c := styles.MultiSelectSelector.String()
-	for i, option := range m.options {
+	start, end := 0, len(m.options)
+	scrolling := m.height > 0 && len(m.options) > m.height
+	if scrolling {
+		start, end = m.offset, min(m.offset+m.height, len(m.options))
+		sb.WriteString(moreView(styles.MoreAbove, c, start > 0) + "\n")
+	}
+	for i := start; i < end; i++ {
+		option := m.options[i]
 		if m.cursor == i {
 			sb.WriteString(c)
 		} else {
@@ -211,11 +494,14 @@ func (m *MultiSelect[T]) View() string {
 			sb.WriteString(styles.UnselectedPrefix.String())
 			sb.WriteString(styles.UnselectedOption.Render(option.Key))
 		}
-		if i < len(m.options)-1 {
+		if i < end-1 {
 			sb.WriteString("\n")
 		}
 	}
-	return styles.Base.Render(sb.String())
+	if scrolling {
+		sb.WriteString("\n" + moreView(styles.MoreBelow, c, end < len(m.options)))
+	}
+	return fieldBase(styles, m.noPadding).Render(sb.String())
 }
 
 func (m *MultiSelect[T]) printOptions() {
@@ -228,14 +514,14 @@ func (m *MultiSelect[T]) printOptions() {
 
 	for i, option := range m.options {
 		if option.selected {
-			sb.WriteString(m.theme.Focused.SelectedOption.Render(fmt.Sprintf("%d. %s %s", i+1, "✓", option.Key)))
+			sb.WriteString(m.theme.Focused.SelectedOption.Render(m.strings.formatOption(i, "✓ "+option.Key)))
 		} else {
-			sb.WriteString(fmt.Sprintf("%d. %s %s", i+1, " ", option.Key))
+			sb.WriteString(m.strings.formatOption(i, "  "+option.Key))
 		}
 		sb.WriteString("\n")
 	}
 
-	fmt.Println(m.theme.Blurred.Base.Render(sb.String()))
+	fmt.Println(accessibleBlock(m.theme.Blurred.Base, sb.String(), m.width))
 }
 
 // Run runs the multi-select field.
@@ -248,27 +534,47 @@ func (m *MultiSelect[T]) Run() error {
 
 // runAccessible() runs the multi-select field in accessible mode.
 func (m *MultiSelect[T]) runAccessible() error {
+	m.selectOptions()
 	m.printOptions()
 
-	var choice int
 	for {
-		fmt.Printf("Select up to %d options. 0 to continue.\n", m.limit)
+		fmt.Printf(m.strings.SelectLimit+"\n", m.limit)
 
-		choice = accessibility.PromptInt("Select: ", 0, len(m.options))
+		input, err := promptString(m.strings, m.strings.Select, func(input string) error {
+			if m.isAnswer(input, m.strings.SelectAll) || m.isAnswer(input, m.strings.SelectNone) {
+				return nil
+			}
+			if i, err := parseChoice(input); err != nil || i < 0 || i > len(m.options) {
+				return errors.New(m.strings.InvalidInput)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		if m.isAnswer(input, m.strings.SelectAll) || m.isAnswer(input, m.strings.SelectNone) {
+			if m.isAnswer(input, m.strings.SelectAll) {
+				m.selectAll()
+			} else {
+				m.clearAll()
+			}
+			m.printOptions()
+			continue
+		}
+		choice, _ := parseChoice(input)
 		if choice == 0 {
 			m.finalize()
-			err := m.validate(*m.value)
-			if err != nil {
-				fmt.Println(err)
+			if m.err != nil {
+				fmt.Println(m.strings.reprompt(m.err))
 				continue
 			}
 			break
 		}
 		m.options[choice-1].selected = !m.options[choice-1].selected
 		if m.options[choice-1].selected {
-			fmt.Printf("Selected: %s\n\n", m.options[choice-1].Key)
+			fmt.Printf("%s%s\n\n", m.strings.Selected, m.options[choice-1].Key)
 		} else {
-			fmt.Printf("Deselected: %s\n\n", m.options[choice-1].Key)
+			fmt.Printf("%s%s\n\n", m.strings.Deselected, m.options[choice-1].Key)
 		}
 
 		m.printOptions()
@@ -278,15 +584,19 @@ func (m *MultiSelect[T]) runAccessible() error {
 
 	for _, option := range m.options {
 		if option.selected {
-			*m.value = append(*m.value, option.Value)
 			values = append(values, option.Key)
 		}
 	}
 
-	fmt.Println(m.theme.Focused.SelectedOption.Render("Selected:", strings.Join(values, ", ")+"\n"))
+	fmt.Println(m.theme.Focused.SelectedOption.Render(m.strings.Selected + strings.Join(values, ", ") + "\n"))
 	return nil
 }
 
+// isAnswer returns whether the input is the given accessible answer.
+func (m *MultiSelect[T]) isAnswer(input, answer string) bool {
+	return strings.EqualFold(strings.TrimSpace(input), answer)
+}
+
 // WithTheme sets the theme of the multi-select field.
 func (m *MultiSelect[T]) WithTheme(theme *Theme) Field {
 	m.theme = theme
@@ -305,12 +615,62 @@ func (m *MultiSelect[T]) WithAccessible(accessible bool) Field {
 	return m
 }
 
+// WithStrings sets the user-facing strings of the multi-select field.
+func (m *MultiSelect[T]) WithStrings(strings *Strings) Field {
+	m.strings = strings
+	return m
+}
+
 // WithWidth sets the width of the multi-select field.
 func (m *MultiSelect[T]) WithWidth(width int) Field {
 	m.width = width
 	return m
 }
 
+// WithoutPadding sets whether the multi-select field is rendered without the borders
+// and padding of its theme.
+func (m *MultiSelect[T]) WithoutPadding(v bool) Field {
+	m.noPadding = v
+	return m
+}
+
+// WithDescriptionVisibility sets when the description of the multi-select field is
+// shown.
+func (m *MultiSelect[T]) WithDescriptionVisibility(v DescriptionVisibility) Field {
+	m.descVisibility = v
+	return m
+}
+
+// WithDescriptionHeight limits the description of the multi-select field to the
+// given number of lines.
+func (m *MultiSelect[T]) WithDescriptionHeight(height int) Field {
+	m.descScroll.height = height
+	return m
+}
+
+// scrollDescription scrolls the description of the multi-select field.
+func (m *MultiSelect[T]) scrollDescription(delta int) {
+	m.descScroll.scroll(m.description, delta)
+}
+
+// WithUpdateHook sets the update hook of the multi-select field, see
+// Input.WithUpdateHook.
+func (m *MultiSelect[T]) WithUpdateHook(hook func(tea.Msg) tea.Msg) Field {
+	m.updateHook = hook
+	return m
+}
+
+// withRecover sets whether the multi-select field recovers from panics in its
+// callbacks.
+func (m *MultiSelect[T]) withRecover(v bool) {
+	m.failFast = !v
+}
+
+// withValidationMode sets when the multi-select field validates its value.
+func (m *MultiSelect[T]) withValidationMode(mode ValidationMode) {
+	m.validationMode = mode
+}
+
 // GetKey returns the multi-select's key.
 func (m *MultiSelect[T]) GetKey() string {
 	return m.key
@@ -320,3 +680,29 @@ func (m *MultiSelect[T]) GetKey() string {
 func (m *MultiSelect[T]) GetValue() any {
 	return *m.value
 }
+
+// GetTitle returns the multi-select's title.
+func (m *MultiSelect[T]) GetTitle() string {
+	return m.title
+}
+
+// DisplayValue returns the keys of the options matching the multi-select's
+// value.
+func (m *MultiSelect[T]) DisplayValue() string {
+	var keys []string
+	for _, option := range m.options {
+		if m.set != nil {
+			if m.set.has(option.Value) {
+				keys = append(keys, option.Key)
+			}
+			continue
+		}
+		for _, v := range *m.value {
+			if equal(option.Value, v) {
+				keys = append(keys, option.Key)
+				break
+			}
+		}
+	}
+	return strings.Join(keys, ", ")
+}
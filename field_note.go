@@ -1,8 +1,12 @@
 package huh
 
 import (
+	"encoding/base64"
 	"fmt"
+	"os"
+	"path/filepath"
 	"strings"
+	"sync/atomic"
 
 	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
@@ -22,6 +26,10 @@ type Note struct {
 	// customization
 	title       string
 	description string
+	titleFunc   titleFunc
+	image       string // marker of the image's escape sequence, see Image
+	imageID     uint32
+	imageAlt    string
 
 	// state
 	showNextButton bool
@@ -29,10 +37,16 @@ type Note struct {
 	renderer       *glamour.TermRenderer
 
 	// options
-	width      int
-	accessible bool
-	theme      *Theme
-	keymap     *NoteKeyMap
+	width          int
+	tabIndex       int
+	accessible     bool
+	updateHook     func(tea.Msg) tea.Msg
+	noPadding      bool
+	descVisibility DescriptionVisibility
+	descScroll     descriptionScroll
+	theme          *Theme
+	keymap         *NoteKeyMap
+	strings        *Strings
 }
 
 // NewNote creates a new note field.
@@ -47,10 +61,11 @@ func NewNote() *Note {
 		r, _ = glamour.NewTermRenderer()
 	}
 
-	return &Note{
+	return withDefaults(&Note{
 		showNextButton: false,
+		strings:        DefaultStrings(),
 		renderer:       r,
-	}
+	})
 }
 
 // Title sets the title of the note field.
@@ -59,18 +74,111 @@ func (n *Note) Title(title string) *Note {
 	return n
 }
 
+// TitleFunc sets a function computing the title of the note field, which
+// is recomputed when the value bindings points to changes.
+func (n *Note) TitleFunc(f func() string, bindings any) *Note {
+	n.titleFunc = titleFunc{f: f, bindings: bindings}
+	n.titleFunc.update(&n.title)
+	return n
+}
+
+// updateBindings recomputes the title of the note field if the value of
+// its bindings changed.
+func (n *Note) updateBindings() tea.Cmd {
+	n.titleFunc.update(&n.title)
+	return nil
+}
+
 // Description sets the description of the note field.
 func (n *Note) Description(description string) *Note {
 	n.description = description
 	return n
 }
 
+// Image sets the path of a PNG image, such as a logo, to show below the
+// description of the note field. The path is resolved when it's set.
+//
+// The image is only shown on terminals known to support the kitty graphics
+// protocol, others show the alt text set with ImageAlt instead, if any. So do
+// notes whose image can't be found.
+func (n *Note) Image(path string) *Note {
+	n.image = ""
+	path, err := filepath.Abs(path)
+	if err != nil || !strings.EqualFold(filepath.Ext(path), ".png") {
+		return n
+	}
+	if _, err := os.Stat(path); err != nil {
+		return n
+	}
+	// Transmit the file by path and display it over the reserved rows
+	// without moving the cursor. Each note has its own image id, so that
+	// redrawing replaces its placement but not those of other notes. The id
+	// is kept when the image is set again, which then reuses its marker.
+	if n.imageID == 0 {
+		n.imageID = atomic.AddUint32(&imageIDs, 1)
+	}
+	n.image = deferEscape(fmt.Sprintf("\x1b_Gf=100,t=f,a=T,i=%d,p=1,r=%d,C=1,q=2;%s\x1b\\",
+		n.imageID, imageRows, base64.StdEncoding.EncodeToString([]byte(path))), "")
+	return n
+}
+
+// ImageAlt sets the text shown in place of the image of the note field where
+// it can't be shown, including in accessible mode.
+func (n *Note) ImageAlt(alt string) *Note {
+	n.imageAlt = alt
+	return n
+}
+
+// imageRows is the number of rows the image of a note takes.
+const imageRows = 8
+
+// imageIDs is the last image id given to a note field.
+var imageIDs uint32
+
+// imageView renders the image of the note field, or its alt text if the
+// terminal may not be able to show it.
+func (n *Note) imageView(styles FieldStyles) string {
+	if n.image != "" && supportsKittyGraphics() {
+		return n.image + strings.Repeat("\n", imageRows)
+	}
+	if n.imageAlt == "" {
+		return ""
+	}
+	return styles.Description.Render(n.imageAlt) + "\n"
+}
+
+// supportsKittyGraphics reports whether the terminal is known to support the
+// kitty graphics protocol. Terminals are only detected by their environment,
+// so that unknown terminals never get escape sequences they can't handle.
+func supportsKittyGraphics() bool {
+	if os.Getenv("KITTY_WINDOW_ID") != "" || os.Getenv("TERM") == "xterm-kitty" {
+		return true
+	}
+	switch os.Getenv("TERM_PROGRAM") {
+	case "WezTerm", "ghostty":
+		return true
+	}
+	return false
+}
+
 // Next sets whether to show the next button.
 func (n *Note) Next(show bool) *Note {
 	n.showNextButton = show
 	return n
 }
 
+// TabIndex sets the position of the note field in its group's focus
+// order, see Group for how tab indices are ordered.
+func (n *Note) TabIndex(index int) *Note {
+	n.tabIndex = index
+	return n
+}
+
+// getTabIndex returns the tab index of the note field.
+func (n *Note) getTabIndex() int {
+	return n.tabIndex
+}
+
 // Focus focuses the note field.
 func (n *Note) Focus() tea.Cmd {
 	n.focused = true
@@ -88,6 +196,14 @@ func (n *Note) Error() error {
 	return nil
 }
 
+// SetError does nothing, notes can't have errors.
+func (n *Note) SetError(error) {}
+
+// Warning returns the warning of the note field.
+func (n *Note) Warning() string {
+	return ""
+}
+
 // KeyBinds returns the help message for the note field.
 func (n *Note) KeyBinds() []key.Binding {
 	return []key.Binding{n.keymap.Next}
@@ -95,11 +211,16 @@ func (n *Note) KeyBinds() []key.Binding {
 
 // Init initializes the note field.
 func (n *Note) Init() tea.Cmd {
+	n.titleFunc.update(&n.title)
 	return nil
 }
 
 // Update updates the note field.
 func (n *Note) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg = runUpdateHook(n.updateHook, msg); msg == nil {
+		return n, nil
+	}
+
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
 		switch {
@@ -120,23 +241,26 @@ func (n *Note) View() string {
 		styles = n.theme.Focused
 	}
 
-	var (
-		sb   strings.Builder
-		body string
-	)
+	var sb strings.Builder
+	sb.WriteString(n.descScroll.view(n.content()))
+	sb.WriteString(n.imageView(styles))
+	if n.showNextButton {
+		sb.WriteString(styles.Next.Render(n.strings.Next))
+	}
+	return fieldBase(styles, n.noPadding).Render(sb.String())
+}
 
+// content returns the title and description of the note field rendered as
+// markdown.
+func (n *Note) content() string {
+	var body string
 	if n.title != "" {
 		body = fmt.Sprintf("# %s\n", n.title)
 	}
-
 	body += n.description
 
 	md, _ := n.renderer.Render(body)
-	sb.WriteString(md)
-	if n.showNextButton {
-		sb.WriteString(styles.Next.Render("Next"))
-	}
-	return styles.Base.Render(sb.String())
+	return md
 }
 
 // Run runs the note field.
@@ -157,8 +281,12 @@ func (n *Note) runAccessible() error {
 
 	body += n.description
 
+	if n.imageAlt != "" {
+		body += "\n\n" + n.imageAlt
+	}
+
 	md, _ := n.renderer.Render(body)
-	fmt.Println(n.theme.Blurred.Base.Render(strings.TrimSpace(md)))
+	fmt.Println(accessibleBlock(n.theme.Blurred.Base, strings.TrimSpace(md), n.width))
 	fmt.Println()
 	return nil
 }
@@ -181,12 +309,50 @@ func (n *Note) WithAccessible(accessible bool) Field {
 	return n
 }
 
+// WithStrings sets the user-facing strings of the note field.
+func (n *Note) WithStrings(strings *Strings) Field {
+	n.strings = strings
+	return n
+}
+
 // WithWidth sets the width of the note field.
 func (n *Note) WithWidth(width int) Field {
 	n.width = width
 	return n
 }
 
+// WithoutPadding sets whether the note field is rendered without the borders
+// and padding of its theme.
+func (n *Note) WithoutPadding(v bool) Field {
+	n.noPadding = v
+	return n
+}
+
+// WithDescriptionVisibility does nothing, the description of a note is its
+// content and is always shown.
+func (n *Note) WithDescriptionVisibility(DescriptionVisibility) Field {
+	return n
+}
+
+// WithDescriptionHeight limits the content of the note field to the given
+// number of lines, scrolling through the rendered markdown.
+func (n *Note) WithDescriptionHeight(height int) Field {
+	n.descScroll.height = height
+	return n
+}
+
+// scrollDescription scrolls the content of the note field.
+func (n *Note) scrollDescription(delta int) {
+	n.descScroll.scroll(n.content(), delta)
+}
+
+// WithUpdateHook sets the update hook of the note field, see
+// Input.WithUpdateHook.
+func (n *Note) WithUpdateHook(hook func(tea.Msg) tea.Msg) Field {
+	n.updateHook = hook
+	return n
+}
+
 // GetValue satisfies the Field interface, notes do not have values.
 func (n *Note) GetValue() any {
 	return nil
@@ -197,6 +363,16 @@ func (n *Note) GetKey() string {
 	return ""
 }
 
+// GetTitle returns the title of the note field.
+func (n *Note) GetTitle() string {
+	return n.title
+}
+
+// DisplayValue returns the description of the note field.
+func (n *Note) DisplayValue() string {
+	return n.description
+}
+
 // pointerTo returns a pointer to a value.
 func pointerTo[T any](v T) *T {
 	return &v
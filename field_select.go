@@ -2,41 +2,109 @@ package huh
 
 import (
 	"fmt"
+	"reflect"
+	"sort"
 	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/spinner"
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
-	"github.com/charmbracelet/huh/accessibility"
 	"github.com/charmbracelet/lipgloss"
 )
 
 // Select is a form select field.
 type Select[T any] struct {
-	value *T
-	key   string
+	value  *T
+	key    string
+	getter func() T
+	setter func(T)
 
 	// customization
 	title           string
 	description     string
+	titleFunc       titleFunc
 	options         []Option[T]
+	visibleOptions  []Option[T]
 	filteredOptions []Option[T]
+	defaultValue    *T
+	envDefault      string
+	envValue        *T
+	less            func(a, b Option[T]) bool
+
+	// dynamic options, recomputed when the value of bindings changes
+	optionsFunc     func() []Option[T]
+	bindings        any
+	boundValue      any
+	optionsDebounce time.Duration
+	optionsGen      int
+	loading         bool
+	spinner         spinner.Model
+
+	// availability of options, checked in the background
+	statusFunc func(Option[T]) tea.Cmd
+	statuses   map[int]optionStatus
+	statusGen  int
 
 	// error handling
-	validate func(T) error
-	err      error
+	validate       func(T) error
+	validateOption func(Option[T]) error
+	err            error
+	setErr         setError
+	warn           func(T) string
+	warning        string
 
 	// state
-	selected  int
-	focused   bool
-	filtering bool
-	filter    textinput.Model
+	selected   int
+	offset     int
+	focused    bool
+	filterable bool
+	filtering  bool
+	filter     textinput.Model
+	tooltip    bool
+	expanded   bool
+	confirming bool
+	bordered   bool
+
+	// the option under the cursor when the filter opened, which the cursor
+	// goes back to when the filter is cleared
+	filterSelected *T
+
+	// the option the pending confirmation asks about
+	confirmOption Option[T]
+
+	// renders the text of options in place of their keys
+	renderer func(option Option[T], selected, focused bool) string
+
+	// groups of options, by whether they're collapsed
+	collapsible     bool
+	collapsedGroups map[string]bool
 
 	// options
-	width      int
-	accessible bool
-	theme      *Theme
-	keymap     *SelectKeyMap
+	width          int
+	height         int
+	columns        int
+	format         func(T) string
+	numbering      bool
+	accelAdvance   bool
+	scrollbar      bool
+	blurredSummary bool
+	dropdown       bool
+	tabIndex       int
+	accessible     bool
+	updateHook     func(tea.Msg) tea.Msg
+	failFast       bool
+	validationMode ValidationMode
+	noPadding      bool
+	descVisibility DescriptionVisibility
+	descScroll     descriptionScroll
+	theme          *Theme
+	keymap         *SelectKeyMap
+	remaps         []keyRemap
+	strings        *Strings
 }
 
 // NewSelect returns a new select field.
@@ -44,21 +112,89 @@ func NewSelect[T any]() *Select[T] {
 	filter := textinput.New()
 	filter.Prompt = "/"
 
-	return &Select[T]{
-		options:   []Option[T]{},
-		value:     new(T),
-		validate:  func(T) error { return nil },
-		filtering: false,
-		filter:    filter,
+	return withDefaults(&Select[T]{
+		options:    []Option[T]{},
+		value:      new(T),
+		validate:   func(T) error { return nil },
+		warn:       func(T) string { return "" },
+		strings:    DefaultStrings(),
+		filterable: true,
+		filtering:  false,
+		filter:     filter,
+		spinner:    spinner.New(spinner.WithSpinner(spinner.Dot)),
+	})
+}
+
+// Enum is a type of enumerated constants that can be chosen from with
+// NewSelectEnum.
+type Enum interface {
+	comparable
+	fmt.Stringer
+}
+
+// NewSelectEnum returns a new select field choosing from the given enum
+// values, keyed by their String method.
+func NewSelectEnum[T Enum](values ...T) *Select[T] {
+	options := make([]Option[T], len(values))
+	for i, v := range values {
+		options[i] = NewOption(v.String(), v)
+	}
+	return NewSelect[T]().Options(options...)
+}
+
+// NewSelectFunc returns a new select field choosing from the given items,
+// keyed by the key function, such as a name field of structs.
+func NewSelectFunc[T any](items []T, key func(T) string) *Select[T] {
+	options := make([]Option[T], len(items))
+	for i, item := range items {
+		options[i] = NewOption(key(item), item)
 	}
+	return NewSelect[T]().Options(options...)
 }
 
-// Value sets the value of the select field.
+// Value sets the value of the select field. If the value is set, the cursor
+// starts on the matching option.
 func (s *Select[T]) Value(value *T) *Select[T] {
 	s.value = value
+	s.getter, s.setter = nil, nil
+	s.selectOption()
+	return s
+}
+
+// ValueFunc binds the value of the select field through functions rather than
+// a pointer, for state that can't be pointed to, such as state held by a store.
+// get provides the initial value when the field is built and initialized, and
+// set is called with each value chosen.
+//
+// It replaces a value bound with Value, and the other way around.
+func (s *Select[T]) ValueFunc(get func() T, set func(T)) *Select[T] {
+	s.value = new(T)
+	*s.value = get()
+	s.getter, s.setter = get, set
+	s.selectOption()
 	return s
 }
 
+// commit sets a chosen value, passing it on to the setter, if any.
+func (s *Select[T]) commit(value T) {
+	*s.value = value
+	if s.setter != nil {
+		s.setter(value)
+	}
+}
+
+// setValue sets the value of the select field, moving the cursor to the
+// matching option.
+func (s *Select[T]) setValue(value any) error {
+	v, err := convert[T](value)
+	if err != nil {
+		return err
+	}
+	s.commit(v)
+	s.selectOption()
+	return nil
+}
+
 // Key sets the key of the select field which can be used to retrieve the value
 // after submission.
 func (s *Select[T]) Key(key string) *Select[T] {
@@ -72,6 +208,14 @@ func (s *Select[T]) Title(title string) *Select[T] {
 	return s
 }
 
+// TitleFunc sets a function computing the title of the select field, which
+// is recomputed when the value bindings points to changes.
+func (s *Select[T]) TitleFunc(f func() string, bindings any) *Select[T] {
+	s.titleFunc = titleFunc{f: f, bindings: bindings}
+	s.titleFunc.update(&s.title)
+	return s
+}
+
 // Description sets the description of the select field.
 func (s *Select[T]) Description(description string) *Select[T] {
 	s.description = description
@@ -83,16 +227,726 @@ func (s *Select[T]) Options(options ...Option[T]) *Select[T] {
 	if len(options) <= 0 {
 		return s
 	}
+	s.setOptions(options)
+	s.selectOption()
+	return s
+}
+
+// setOptions sets the options of the select field, in sort order if there is
+// one, with all the visible options shown.
+func (s *Select[T]) setOptions(options []Option[T]) {
+	options = append([]Option[T](nil), options...)
+	if s.less != nil {
+		sort.SliceStable(options, func(i, j int) bool {
+			return s.less(options[i], options[j])
+		})
+	}
+	for i := range options {
+		options[i].index = i
+	}
 	s.options = options
-	s.filteredOptions = options
+	s.updateVisibility()
+	s.filteredOptions = s.visibleOptions
+	s.confirming = false
+	s.resolveEnvDefault()
+}
 
-	// Set the cursor to the last selected option.
-	for i, option := range options {
-		if option.selected {
+// SortOptions sets the order of the options of the select field, which is
+// applied whenever the options are set or recomputed. Options that compare
+// equal keep their order, and the cursor stays on the same option.
+func (s *Select[T]) SortOptions(less func(a, b Option[T]) bool) *Select[T] {
+	s.less = less
+	if len(s.options) <= 0 {
+		return s
+	}
+	var current *T
+	if s.selected < len(s.filteredOptions) {
+		current = &s.filteredOptions[s.selected].Value
+	}
+	s.setOptions(s.options)
+	s.selected = 0
+	for i, option := range s.filteredOptions {
+		if current != nil && equal(option.Value, *current) {
+			s.selected = i
+			break
+		}
+	}
+	return s
+}
+
+// SortOptionsByKey sorts the options of the select field alphabetically by
+// key, ignoring case, such as when they are built from a map.
+func (s *Select[T]) SortOptionsByKey() *Select[T] {
+	return s.SortOptions(func(a, b Option[T]) bool {
+		return strings.ToLower(a.Key) < strings.ToLower(b.Key)
+	})
+}
+
+// updateVisibility updates the options that aren't hidden, see
+// Option.WithHideFunc.
+func (s *Select[T]) updateVisibility() {
+	s.visibleOptions = nil
+	if s.collapsible && !s.accessible {
+		s.visibleOptions = s.groupedOptions()
+		return
+	}
+	for _, option := range s.options {
+		if !option.hidden() {
+			s.visibleOptions = append(s.visibleOptions, option)
+		}
+	}
+}
+
+// refreshVisibility rechecks which options are hidden, keeping the filter.
+// If the value's option became hidden, the first visible option is chosen.
+func (s *Select[T]) refreshVisibility() {
+	s.updateVisibility()
+	s.setFilteredOptions(s.matchingOptions())
+
+	hidden := false
+	for _, option := range s.options {
+		if equal(option.Value, *s.value) {
+			hidden = option.hidden()
+			break
+		}
+	}
+	if hidden {
+		for i, option := range s.visibleOptions {
+			if option.header || option.confirm != "" {
+				continue
+			}
+			s.commit(option.Value)
+			s.filter.SetValue("")
+			s.filterSelected = nil
+			s.setFilteredOptions(s.visibleOptions)
+			s.selected = i
+			break
+		}
+	}
+	s.scroll()
+}
+
+// matchingOptions returns the visible options matching the filter.
+func (s *Select[T]) matchingOptions() []Option[T] {
+	if s.filter.Value() == "" {
+		return s.visibleOptions
+	}
+	// Options of collapsed groups match too, listed without headers.
+	candidates := s.visibleOptions
+	if s.collapsible {
+		candidates = nil
+		for _, option := range s.options {
+			if !option.hidden() {
+				candidates = append(candidates, option)
+			}
+		}
+	}
+	var options []Option[T]
+	for _, option := range candidates {
+		if s.filterFunc(s.optionKey(option)) {
+			options = append(options, option)
+		}
+	}
+	return options
+}
+
+// RemapKey replaces the key from with the keys to in the bindings of the
+// select field, on top of the keymap of its form, such as to move its filter
+// off "/", see KeyMap.Remap.
+func (s *Select[T]) RemapKey(from string, to ...string) *Select[T] {
+	s.remaps = append(s.remaps, keyRemap{from, to})
+	if s.keymap != nil {
+		keymap := *s.keymap
+		remapKeys(keymap.bindings(), s.remaps[len(s.remaps)-1:])
+		s.keymap = &keymap
+	}
+	return s
+}
+
+// WithCollapsibleGroups sets whether the options of the select field are
+// listed under a header for their group, see Option.Group. Options without a
+// group come first.
+//
+// Left collapses the group under the cursor and Right expands it, as does
+// Next on a header. The options of collapsed groups are skipped and counted
+// on their header. Filtering lists the matching options of every group
+// without headers, and accessible mode lists all options.
+func (s *Select[T]) WithCollapsibleGroups(v bool) *Select[T] {
+	s.collapsible = v
+	s.updateVisibility()
+	s.filteredOptions = s.matchingOptions()
+	s.selectOption()
+	return s
+}
+
+// groupedOptions returns the visible options under the headers of their
+// groups, leaving out the options of collapsed groups.
+func (s *Select[T]) groupedOptions() []Option[T] {
+	var options []Option[T]
+	var groups []string
+	members := make(map[string][]Option[T])
+	for _, option := range s.options {
+		switch {
+		case option.hidden():
+		case option.group == "":
+			options = append(options, option)
+		default:
+			if _, ok := members[option.group]; !ok {
+				groups = append(groups, option.group)
+			}
+			members[option.group] = append(members[option.group], option)
+		}
+	}
+	for _, group := range groups {
+		options = append(options, Option[T]{Key: group, group: group, header: true})
+		if !s.collapsedGroups[group] {
+			options = append(options, members[group]...)
+		}
+	}
+	return options
+}
+
+// groupSize returns the number of visible options in the group.
+func (s *Select[T]) groupSize(group string) int {
+	n := 0
+	for _, option := range s.options {
+		if option.group == group && !option.hidden() {
+			n++
+		}
+	}
+	return n
+}
+
+// collapses returns whether Left and Right collapse and expand groups, which
+// they do unless the options are in columns or filtered.
+func (s *Select[T]) collapses() bool {
+	return s.collapsible && s.columns <= 1 && !s.filtering && s.filter.Value() == ""
+}
+
+// setCollapsed collapses or expands the group of the option under the cursor,
+// moving the cursor to its header.
+func (s *Select[T]) setCollapsed(collapsed bool) {
+	if s.selected >= len(s.filteredOptions) || s.filteredOptions[s.selected].group == "" {
+		return
+	}
+	group := s.filteredOptions[s.selected].group
+	if s.collapsedGroups == nil {
+		s.collapsedGroups = make(map[string]bool)
+	}
+	s.collapsedGroups[group] = collapsed
+	s.updateVisibility()
+	s.filteredOptions = s.visibleOptions
+	for i, option := range s.filteredOptions {
+		if option.header && option.group == group {
+			s.selected = i
+			break
+		}
+	}
+}
+
+// Filtering sets whether the options of the select field can be filtered,
+// which they can by default. The Filter binding, / by default, opens a filter
+// that lists the options whose keys contain the typed text, ignoring case,
+// and esc clears it to list all options again. Filtering(false) turns the
+// filter off, leaving / to accelerators and custom bindings. Accessible mode
+// always lists all options.
+func (s *Select[T]) Filtering(v bool) *Select[T] {
+	s.filterable = v
+	return s
+}
+
+// OptionsFunc sets a function that returns the options of the select field,
+// for options that depend on other fields. The function is called when the
+// field is initialized and again whenever the value bindings points to
+// changes, such as the value bound to another field.
+//
+// The function runs in the background while a spinner is shown, so it can be
+// slow, such as when it fetches options over the network.
+func (s *Select[T]) OptionsFunc(f func() []Option[T], bindings any) *Select[T] {
+	s.optionsFunc = f
+	s.bindings = bindings
+	return s
+}
+
+// OptionsDebounce sets how long the value bindings points to must be left as
+// is before the options of the select field are recomputed, see OptionsFunc.
+// Options are recomputed right away by default.
+//
+// This keeps options that depend on a field being typed in, such as a search
+// query, from being fetched on every keystroke.
+func (s *Select[T]) OptionsDebounce(d time.Duration) *Select[T] {
+	s.optionsDebounce = d
+	return s
+}
+
+// optionsMsg carries the options computed for a generation of the bindings
+// of a select field.
+type optionsMsg[T any] struct {
+	target  *Select[T]
+	gen     int
+	options []Option[T]
+	err     error
+}
+
+// optionsDebounceMsg is sent once a generation of the bindings of a select
+// field has been left as is for the debounce duration.
+type optionsDebounceMsg[T any] struct {
+	target *Select[T]
+	gen    int
+}
+
+// optionsSpinnerMsg animates the spinner of a select field that is loading
+// options.
+type optionsSpinnerMsg[T any] struct {
+	target *Select[T]
+	tick   spinner.TickMsg
+}
+
+func (optionsMsg[T]) broadcast()         {}
+func (optionsDebounceMsg[T]) broadcast() {}
+func (optionsSpinnerMsg[T]) broadcast()  {}
+
+// updateBindings recomputes the title and starts recomputing the options of
+// the select field if the values of their bindings changed. The options of
+// earlier values are dropped when they arrive, as they are out of date.
+func (s *Select[T]) updateBindings() tea.Cmd {
+	s.titleFunc.update(&s.title)
+	if s.optionsFunc == nil {
+		return nil
+	}
+	value := bindingValue(s.bindings)
+	if s.optionsGen > 0 && reflect.DeepEqual(value, s.boundValue) {
+		return nil
+	}
+	s.boundValue = value
+	s.optionsGen++
+
+	var cmds []tea.Cmd
+	if !s.spinning() {
+		cmds = append(cmds, s.spinnerTick())
+	}
+	s.loading = true
+	target, gen := s, s.optionsGen
+	if s.optionsDebounce > 0 && gen > 1 {
+		cmds = append(cmds, tea.Tick(s.optionsDebounce, func(time.Time) tea.Msg {
+			return optionsDebounceMsg[T]{target: target, gen: gen}
+		}))
+	} else {
+		cmds = append(cmds, s.fetchOptions(gen))
+	}
+	return tea.Batch(cmds...)
+}
+
+// fetchOptions returns the command that computes the options for a
+// generation of the bindings. A panic of the function becomes the error of the
+// field when recovering.
+func (s *Select[T]) fetchOptions(gen int) tea.Cmd {
+	target, f, recovering := s, s.optionsFunc, !s.failFast
+	return func() tea.Msg {
+		msg := optionsMsg[T]{target: target, gen: gen}
+		func() {
+			defer recoverAsError(recovering, &msg.err)
+			msg.options = f()
+		}()
+		return msg
+	}
+}
+
+// spinnerTick returns the next tick of the spinner of the select field,
+// which reaches the field wherever it is in the form.
+func (s *Select[T]) spinnerTick() tea.Cmd {
+	target, id := s, s.spinner.ID()
+	return tea.Tick(s.spinner.Spinner.FPS, func(t time.Time) tea.Msg {
+		return optionsSpinnerMsg[T]{target: target, tick: spinner.TickMsg{Time: t, ID: id}}
+	})
+}
+
+// updateOptions handles the messages of dynamic options.
+func (s *Select[T]) updateOptions(msg tea.Msg) (bool, tea.Cmd) {
+	switch msg := msg.(type) {
+	case optionsMsg[T]:
+		if msg.target == s && msg.gen == s.optionsGen {
+			s.loading = false
+			if msg.err != nil {
+				s.err = msg.err
+				return true, nil
+			}
+			s.setOptions(msg.options)
+			s.selected = 0
+			s.selectOption()
+			s.scroll()
+			return true, s.checkOptions()
+		}
+		return true, nil
+	case optionsDebounceMsg[T]:
+		if msg.target == s && msg.gen == s.optionsGen {
+			return true, s.fetchOptions(msg.gen)
+		}
+		return true, nil
+	case optionStatusMsg[T]:
+		if msg.target == s && msg.gen == s.statusGen {
+			s.statuses[msg.index] = msg.status
+			if !s.available(s.selected) {
+				s.confirming = false
+				s.moveTo(s.selected, 1)
+				s.moveTo(s.selected, -1)
+			}
+		}
+		return true, nil
+	case optionsSpinnerMsg[T]:
+		if msg.target != s || !s.spinning() {
+			return true, nil
+		}
+		s.spinner, _ = s.spinner.Update(msg.tick)
+		return true, s.spinnerTick()
+	}
+	return false, nil
+}
+
+// OptionStatusMsg reports whether an option of a select field can be chosen,
+// see Select.WithOptionStatusFunc.
+type OptionStatusMsg struct {
+	Available bool
+
+	// Reason is shown next to an option that isn't available.
+	Reason string
+}
+
+// optionStatus is the availability of an option of a select field.
+type optionStatus struct {
+	checked bool
+	OptionStatusMsg
+}
+
+// optionStatusMsg carries the availability of the option at the given index,
+// checked for a generation of the options of a select field.
+type optionStatusMsg[T any] struct {
+	target *Select[T]
+	gen    int
+	index  int
+	status optionStatus
+}
+
+func (optionStatusMsg[T]) broadcast() {}
+
+// WithOptionStatusFunc sets a function that returns a command checking whether
+// an option of the select field is available, such as a region being online.
+// The command ends with an OptionStatusMsg, or with an error for an option that
+// isn't available; any other message, or a nil command, makes it available.
+//
+// The options are checked in the background whenever they are set, with a
+// spinner shown next to each until its check is done. Navigation skips the
+// options that are still being checked and those that aren't available, and
+// they can't be chosen. Accessible mode doesn't check options.
+func (s *Select[T]) WithOptionStatusFunc(f func(option Option[T]) tea.Cmd) *Select[T] {
+	s.statusFunc = f
+	return s
+}
+
+// checkOptions starts checking the availability of the options of the select
+// field. The results of earlier options are dropped when they arrive.
+func (s *Select[T]) checkOptions() tea.Cmd {
+	if s.statusFunc == nil {
+		return nil
+	}
+	spinning := s.spinning()
+	s.statusGen++
+	s.statuses = make(map[int]optionStatus, len(s.options))
+
+	var cmds []tea.Cmd
+	target, gen := s, s.statusGen
+	for _, option := range s.options {
+		cmd := s.statusFunc(option)
+		if cmd == nil {
+			s.statuses[option.index] = optionStatus{checked: true, OptionStatusMsg: OptionStatusMsg{Available: true}}
+			continue
+		}
+		s.statuses[option.index] = optionStatus{}
+		index := option.index
+		cmds = append(cmds, func() tea.Msg {
+			status := optionStatus{checked: true, OptionStatusMsg: OptionStatusMsg{Available: true}}
+			switch msg := cmd().(type) {
+			case OptionStatusMsg:
+				status.OptionStatusMsg = msg
+			case error:
+				status.OptionStatusMsg = OptionStatusMsg{Reason: msg.Error()}
+			}
+			return optionStatusMsg[T]{target: target, gen: gen, index: index, status: status}
+		})
+	}
+	if !spinning && s.spinning() {
+		cmds = append(cmds, s.spinnerTick())
+	}
+	return tea.Batch(cmds...)
+}
+
+// checking returns whether the availability of any option is being checked.
+func (s *Select[T]) checking() bool {
+	for _, status := range s.statuses {
+		if !status.checked {
+			return true
+		}
+	}
+	return false
+}
+
+// spinning returns whether the spinner of the select field is shown, while
+// options are loaded or checked.
+func (s *Select[T]) spinning() bool {
+	return s.loading || s.checking()
+}
+
+// busy returns whether the select field is loading or checking options.
+func (s *Select[T]) busy() bool {
+	return s.spinning()
+}
+
+// available returns whether the filtered option at the given index can be
+// chosen, which headers always can as they expand and collapse their group.
+func (s *Select[T]) available(i int) bool {
+	if i < 0 || i >= len(s.filteredOptions) {
+		return false
+	}
+	option := s.filteredOptions[i]
+	status, ok := s.statuses[option.index]
+	return option.header || !ok || (status.checked && status.Available)
+}
+
+// moveTo moves the cursor to the first available option from the given index
+// in the given direction, leaving it as is if there is none.
+func (s *Select[T]) moveTo(i, dir int) {
+	for ; i >= 0 && i < len(s.filteredOptions); i += dir {
+		if s.available(i) {
+			s.selected = i
+			return
+		}
+	}
+}
+
+// Default sets the recommended option of the select field, which is tagged as
+// the default wherever the cursor is.
+//
+// The cursor starts on the default option unless an option is marked as
+// selected.
+func (s *Select[T]) Default(value T) *Select[T] {
+	s.defaultValue = &value
+	s.selectOption()
+	return s
+}
+
+// DefaultFromEnv sets the default option of the select field from the named
+// environment variable. The option whose key, or formatted value, equals the
+// variable is the default and the cursor starts on it when the form starts.
+//
+// A set, non-empty variable that matches an option takes precedence over
+// Default and the value the field was given. Otherwise those apply as usual.
+func (s *Select[T]) DefaultFromEnv(name string) *Select[T] {
+	s.envDefault = name
+	s.resolveEnvDefault()
+	return s
+}
+
+// resolveEnvDefault finds the option matching the environment variable of the
+// select field, if any.
+func (s *Select[T]) resolveEnvDefault() {
+	s.envValue = nil
+	env, ok := lookupEnv(s.envDefault)
+	if !ok {
+		return
+	}
+	for _, option := range s.options {
+		if option.Key == env || fmt.Sprint(option.Value) == env {
+			value := option.Value
+			s.envValue = &value
+			return
+		}
+	}
+}
+
+// defaultOption returns the value of the default option, which is the one
+// matching the environment variable if any, or nil if there is none.
+func (s *Select[T]) defaultOption() *T {
+	if s.envValue != nil {
+		return s.envValue
+	}
+	return s.defaultValue
+}
+
+// selectOption sets the cursor to the last selected option. If none are
+// selected, it's set to the option matching the value if it's set, or to the
+// default option.
+func (s *Select[T]) selectOption() {
+	selected, matched, def := -1, -1, -1
+	hasValue := !reflect.ValueOf(s.value).Elem().IsZero()
+	for i, option := range s.visibleOptions {
+		switch {
+		case option.header:
+		case option.selected:
+			selected = i
+		case matched < 0 && hasValue && equal(option.Value, *s.value):
+			matched = i
+		case def < 0 && s.isDefault(option):
+			def = i
+		}
+	}
+	for _, i := range []int{selected, matched, def} {
+		if i >= 0 {
 			s.selected = i
+			return
+		}
+	}
+}
+
+// isDefault returns whether the option is the default option.
+func (s *Select[T]) isDefault(option Option[T]) bool {
+	def := s.defaultOption()
+	return def != nil && !option.header && equal(option.Value, *def)
+}
+
+// optionValues returns the values of the options of the select field.
+func (s *Select[T]) optionValues() []string {
+	return jsonValues(s.options)
+}
+
+// Height sets the number of options to show at once.
+//
+// When there are more options than fit, the options scroll to keep the cursor
+// in view, with arrows above and below them while there are more options that
+// way, unless there is a scrollbar. A height of zero shows all options.
+func (s *Select[T]) Height(height int) *Select[T] {
+	s.height = height
+	return s
+}
+
+// WithBorderedList sets whether the options of the select field are drawn in a
+// box of their own, for menu-style fields. The box fits the width of the
+// field, and Height still counts the rows of options inside it.
+func (s *Select[T]) WithBorderedList(v bool) *Select[T] {
+	s.bordered = v
+	return s
+}
+
+// listBorder returns the style of the box around the options, which only has
+// a border when the select field has a bordered list.
+func (s *Select[T]) listBorder(styles FieldStyles) lipgloss.Style {
+	if !s.bordered {
+		return lipgloss.NewStyle()
+	}
+	return lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(styles.SelectSelector.GetForeground()).
+		PaddingRight(1)
+}
+
+// listWidth returns the width available to the options of the select field
+// inside its padding and list border, or 0 if the field has no width.
+func (s *Select[T]) listWidth(styles FieldStyles) int {
+	if s.width <= 0 {
+		return 0
+	}
+	frame := fieldBase(styles, s.noPadding).GetHorizontalFrameSize() + s.listBorder(styles).GetHorizontalFrameSize()
+	return max(s.width-frame, 0)
+}
+
+// WithOptionRenderer sets a function rendering the rows of the options of the
+// select field, for rich rows with badges, columns or colors. It's given the
+// option, whether the cursor is on it and whether the field is focused, and
+// its result takes the place of the styled key and default tag.
+//
+// The select field still draws the selector and numbers before each row, and
+// lays out, scrolls and filters the rows. Fit rows to the width of the field
+// minus SelectorWidth.
+func (s *Select[T]) WithOptionRenderer(render func(option Option[T], selected, focused bool) string) *Select[T] {
+	s.renderer = render
+	return s
+}
+
+// SelectorWidth returns the width of the column before the rows of options,
+// which holds the selector and the numbers of numbered options.
+func (s *Select[T]) SelectorWidth() int {
+	styles := s.theme.fieldStyles(s.focused, s.err)
+	width := lipgloss.Width(styles.SelectSelector.String())
+	if s.numbering && len(s.filteredOptions) > 0 {
+		width += lipgloss.Width(s.numberView(styles, 0))
+	}
+	return width
+}
+
+// FormatOption sets the function that renders the options of the select
+// field, taking the place of their keys. As it's called when rendering, it
+// can localize or reformat the options without rebuilding them.
+func (s *Select[T]) FormatOption(format func(T) string) *Select[T] {
+	s.format = format
+	return s
+}
+
+// optionKey returns the text shown for an option.
+func (s *Select[T]) optionKey(option Option[T]) string {
+	if s.format != nil {
+		return s.format(option.Value)
+	}
+	return option.Key
+}
+
+// WithNumbering sets whether the options of the select field are numbered
+// like in accessible mode, so that users can refer to them by number. Typing
+// a number from 1 to 9 moves the cursor to that option.
+func (s *Select[T]) WithNumbering(v bool) *Select[T] {
+	s.numbering = v
+	return s
+}
+
+// numberView renders the number of the filtered option at the given index,
+// padded to the width of the largest number.
+func (s *Select[T]) numberView(styles FieldStyles, i int) string {
+	last := s.strings.Enumerator(len(s.filteredOptions)-1) + ". "
+	number := s.strings.Enumerator(i) + ". "
+	return styles.OptionNumber.Render(number + strings.Repeat(" ", lipgloss.Width(last)-lipgloss.Width(number)))
+}
+
+// AdvanceOnAccelerator sets whether choosing an option with its accelerator,
+// see Option.Accelerator, also moves on to the next field.
+func (s *Select[T]) AdvanceOnAccelerator(v bool) *Select[T] {
+	s.accelAdvance = v
+	return s
+}
+
+// accelerated returns the index of the filtered option with the key pressed
+// as its accelerator, or -1 if there is none.
+func (s *Select[T]) accelerated(msg tea.KeyMsg) int {
+	if msg.Type != tea.KeyRunes || len(msg.Runes) != 1 {
+		return -1
+	}
+	for i, option := range s.filteredOptions {
+		if option.accelerator != 0 && option.accelerator == msg.Runes[0] {
+			return i
 		}
 	}
+	return -1
+}
 
+// WithScrollbar sets whether a scrollbar is shown to the right of the options
+// when they scroll within the height of the select field.
+func (s *Select[T]) WithScrollbar(v bool) *Select[T] {
+	s.scrollbar = v
+	return s
+}
+
+// Columns sets the number of columns to flow the options into, which saves
+// space when there are many short options. Options fill the columns from top
+// to bottom, so only the last column can be shorter.
+//
+// Left and right move between columns. Height doesn't apply to options in
+// columns.
+func (s *Select[T]) Columns(columns int) *Select[T] {
+	s.columns = columns
+	return s
+}
+
+// WithBlurredSummary sets whether the select field collapses to a single
+// "Title: value" line while blurred, expanding to the options when focused.
+func (s *Select[T]) WithBlurredSummary(v bool) *Select[T] {
+	s.blurredSummary = v
 	return s
 }
 
@@ -102,36 +956,231 @@ func (s *Select[T]) Validate(validate func(T) error) *Select[T] {
 	return s
 }
 
+// ValidateOption sets a validation function of the select field that gets the
+// whole chosen option rather than just its value, for rules based on the
+// option itself, such as its key.
+//
+// If both validation functions are set, the option is validated first, and
+// the value is only validated if the option is valid.
+func (s *Select[T]) ValidateOption(validate func(Option[T]) error) *Select[T] {
+	s.validateOption = validate
+	return s
+}
+
+// validateValue validates the option of the value, see valueOption, if there
+// is an option validation function, followed by the value.
+func (s *Select[T]) validateValue(value T) error {
+	if option, ok := s.valueOption(value); ok {
+		return s.validateChoice(option)
+	}
+	return s.validate(value)
+}
+
+// validateChoice validates an option, if there is an option validation
+// function, followed by its value.
+func (s *Select[T]) validateChoice(option Option[T]) error {
+	if s.validateOption != nil {
+		if err := s.validateOption(option); err != nil {
+			return err
+		}
+	}
+	return s.validate(option.Value)
+}
+
+// valueOption returns the option of a value: the option under the cursor if
+// it has the value, as options can share a value, or else the first option
+// with it.
+func (s *Select[T]) valueOption(value T) (Option[T], bool) {
+	if s.selected >= 0 && s.selected < len(s.filteredOptions) {
+		option := s.filteredOptions[s.selected]
+		if !option.header && equal(option.Value, value) {
+			return option, true
+		}
+	}
+	for _, option := range s.options {
+		if equal(option.Value, value) {
+			return option, true
+		}
+	}
+	return Option[T]{}, false
+}
+
 // Error returns the error of the select field.
 func (s *Select[T]) Error() error {
 	return s.err
 }
 
+// Warn sets the warning function of the select field.
+//
+// Warnings are shown like errors but don't prevent the user from moving on,
+// which is useful for unusual but allowed values. A warning is returned as a
+// non-empty string.
+func (s *Select[T]) Warn(warn func(T) string) *Select[T] {
+	s.warn = warn
+	return s
+}
+
+// Warning returns the warning of the select field.
+func (s *Select[T]) Warning() string {
+	return s.warning
+}
+
+// SetError sets an error on the select field, which is shown until it is
+// cleared with nil or the value changes.
+func (s *Select[T]) SetError(err error) {
+	s.err = err
+	s.setErr = setError{err: err, value: *s.value}
+}
+
+// validateField validates the value of the select field.
+func (s *Select[T]) validateField() {
+	s.runValidation(*s.value)
+}
+
+// runValidation validates the value, setting the error and warning of the
+// select field.
+func (s *Select[T]) runValidation(value T) {
+	if s.validationMode == ValidationOnSubmit {
+		return
+	}
+	defer recoverAsError(!s.failFast, &s.err)
+	s.err = s.setErr.get(value)
+	if s.err == nil {
+		s.err = s.validateValue(value)
+	}
+	s.warning = s.warn(value)
+}
+
+// TabIndex sets the position of the select field in its group's focus
+// order, see Group for how tab indices are ordered.
+func (s *Select[T]) TabIndex(index int) *Select[T] {
+	s.tabIndex = index
+	return s
+}
+
+// getTabIndex returns the tab index of the select field.
+func (s *Select[T]) getTabIndex() int {
+	return s.tabIndex
+}
+
+// WithDropdown sets whether the select field is a dropdown, showing only the
+// chosen option on a single line until it's opened. Choosing an option closes
+// it again.
+func (s *Select[T]) WithDropdown(v bool) *Select[T] {
+	s.dropdown = v
+	return s
+}
+
+// collapsed returns whether the select field is a closed dropdown.
+func (s *Select[T]) collapsed() bool {
+	return s.dropdown && !s.expanded
+}
+
 // Focus focuses the select field.
 func (s *Select[T]) Focus() tea.Cmd {
 	s.focused = true
-	return nil
+	s.refreshVisibility()
+	return s.updateBindings()
 }
 
 // Blur blurs the select field.
 func (s *Select[T]) Blur() tea.Cmd {
 	s.focused = false
-	s.err = s.validate(*s.value)
+	s.expanded = false
+	s.confirming = false
+	s.runValidation(*s.value)
 	return nil
 }
 
+// enteringText returns whether the select field takes typed text. Select
+// fields take text while filtering.
+func (s *Select[T]) enteringText() bool {
+	return s.filtering
+}
+
 // KeyBinds returns the help keybindings for the select field.
 func (s *Select[T]) KeyBinds() []key.Binding {
-	return []key.Binding{s.keymap.Up, s.keymap.Down, s.keymap.Filter, s.keymap.SetFilter, s.keymap.ClearFilter, s.keymap.Next, s.keymap.Prev}
+	if s.confirming {
+		return []key.Binding{s.keymap.Accept, s.keymap.Decline}
+	}
+	if s.collapsed() {
+		return []key.Binding{s.keymap.Open, s.keymap.Prev}
+	}
+	binds := []key.Binding{s.keymap.Up, s.keymap.Down}
+	if s.columns > 1 || s.collapses() {
+		binds = append(binds, s.keymap.Left, s.keymap.Right)
+	}
+	if s.height > 0 {
+		binds = append(binds, s.keymap.PageUp, s.keymap.PageDown, s.keymap.Home, s.keymap.End)
+	}
+	if !s.filtering && s.optionDescription(s.selected) != "" {
+		binds = append(binds, s.keymap.Tooltip)
+	}
+	if s.defaultOption() != nil {
+		binds = append(binds, s.keymap.Reset)
+	}
+	if s.filterable {
+		binds = append(binds, s.keymap.Filter, s.keymap.SetFilter, s.keymap.ClearFilter)
+	}
+	return append(binds, s.keymap.Next, s.keymap.Prev)
 }
 
 // Init initializes the select field.
 func (s *Select[T]) Init() tea.Cmd {
-	return nil
+	if s.getter != nil {
+		*s.value = s.getter()
+		s.selectOption()
+	}
+	if s.envValue != nil {
+		for i, option := range s.filteredOptions {
+			if equal(option.Value, *s.envValue) {
+				s.selected = i
+				break
+			}
+		}
+	}
+	return tea.Batch(s.updateBindings(), s.checkOptions())
 }
 
 // Update updates the select field.
 func (s *Select[T]) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg = runUpdateHook(s.updateHook, msg); msg == nil {
+		return s, nil
+	}
+
+	if ok, cmd := s.updateOptions(msg); ok {
+		return s, cmd
+	}
+
+	// Any key dismisses the tooltip.
+	if _, ok := msg.(tea.KeyMsg); ok && s.tooltip {
+		s.tooltip = false
+		return s, nil
+	}
+
+	// A pending confirmation is answered before anything else.
+	if msg, ok := msg.(tea.KeyMsg); ok && s.confirming {
+		switch {
+		case key.Matches(msg, s.keymap.Accept):
+			s.confirming = false
+			return s, s.choose(s.confirmOption.Value)
+		case key.Matches(msg, s.keymap.Decline):
+			s.confirming = false
+		}
+		return s, nil
+	}
+
+	// A closed dropdown only opens or moves on.
+	if msg, ok := msg.(tea.KeyMsg); ok && s.collapsed() {
+		if key.Matches(msg, s.keymap.Open) {
+			s.expanded = true
+			return s, nil
+		}
+		if !key.Matches(msg, s.keymap.Next, s.keymap.Prev) {
+			return s, nil
+		}
+	}
+
 	var cmd tea.Cmd
 	if s.filtering {
 		s.filter, cmd = s.filter.Update(msg)
@@ -140,116 +1189,548 @@ func (s *Select[T]) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
 		s.err = nil
+		defer func() { s.setErr.keep(&s.err, *s.value) }()
+		s.warning = ""
 		switch {
-		case key.Matches(msg, s.keymap.Filter):
+		case s.numbering && !s.filtering && msg.Type == tea.KeyRunes && len(msg.Runes) == 1 &&
+			msg.Runes[0] >= '1' && msg.Runes[0] <= '9':
+			if n := int(msg.Runes[0] - '1'); n < len(s.filteredOptions) {
+				s.selected = n
+			}
+		case key.Matches(msg, s.keymap.Tooltip) && !s.filtering && s.optionDescription(s.selected) != "":
+			s.tooltip = true
+			return s, nil
+		case key.Matches(msg, s.keymap.Reset):
+			s.reset()
+		case s.filtering && typedText(msg):
+			// The filter keeps typed text, even where it's bound, such as
+			// to j and k.
+		case s.filterable && key.Matches(msg, s.keymap.Filter):
+			if s.filter.Value() == "" {
+				s.filterSelected = nil
+				if s.selected < len(s.filteredOptions) && !s.filteredOptions[s.selected].header {
+					value := s.filteredOptions[s.selected].Value
+					s.filterSelected = &value
+				}
+			}
 			s.setFilter(true)
 			return s, s.filter.Focus()
 		case key.Matches(msg, s.keymap.SetFilter):
 			if len(s.filteredOptions) <= 0 {
-				s.filter.SetValue("")
-				s.filteredOptions = s.options
+				s.clearFilter()
 			}
 			s.setFilter(false)
 		case key.Matches(msg, s.keymap.ClearFilter):
-			s.filter.SetValue("")
-			s.filteredOptions = s.options
+			s.clearFilter()
 			s.setFilter(false)
 		case key.Matches(msg, s.keymap.Up):
-			// When filtering we should ignore j/k keybindings
-			if s.filtering && msg.String() == "k" {
+			s.moveTo(s.selected-1, -1)
+		case key.Matches(msg, s.keymap.Down):
+			s.moveTo(s.selected+1, 1)
+		case key.Matches(msg, s.keymap.Left) && s.collapses():
+			s.setCollapsed(true)
+		case key.Matches(msg, s.keymap.Right) && s.collapses():
+			s.setCollapsed(false)
+		case key.Matches(msg, s.keymap.Left) && !s.filtering && s.columns > 1:
+			if rows := s.rows(len(s.filteredOptions)); s.selected >= rows {
+				s.selected -= rows
+			}
+		case key.Matches(msg, s.keymap.Right) && !s.filtering && s.columns > 1:
+			rows := s.rows(len(s.filteredOptions))
+			if s.selected+rows < len(s.filteredOptions) {
+				s.selected += rows
+			} else if s.selected/rows < (len(s.filteredOptions)-1)/rows {
+				// The last column is shorter, move to its last option.
+				s.selected = len(s.filteredOptions) - 1
+			}
+		case key.Matches(msg, s.keymap.PageUp):
+			s.moveTo(max(s.selected-s.pageSize(), 0), 1)
+		case key.Matches(msg, s.keymap.PageDown):
+			s.moveTo(min(s.selected+s.pageSize(), len(s.filteredOptions)-1), -1)
+		case key.Matches(msg, s.keymap.Home):
+			// When filtering the filter input uses home and end.
+			if s.filtering {
 				break
 			}
-			s.selected = max(s.selected-1, 0)
-		case key.Matches(msg, s.keymap.Down):
-			// When filtering we should ignore j/k keybindings
-			if s.filtering && msg.String() == "j" {
+			s.moveTo(0, 1)
+		case key.Matches(msg, s.keymap.End):
+			if s.filtering {
 				break
 			}
-			s.selected = min(s.selected+1, len(s.filteredOptions)-1)
+			s.moveTo(len(s.filteredOptions)-1, -1)
 		case key.Matches(msg, s.keymap.Prev):
 			if s.selected >= len(s.filteredOptions) {
 				break
 			}
+			if !s.available(s.selected) {
+				return s, prevField
+			}
+			if s.filteredOptions[s.selected].header {
+				return s, prevField
+			}
+			if s.filteredOptions[s.selected].confirm != "" {
+				// Options asking for confirmation are only chosen going on.
+				return s, prevField
+			}
 			value := s.filteredOptions[s.selected].Value
-			s.err = s.validate(value)
+			s.runValidation(value)
 			if s.err != nil {
 				return s, nil
 			}
-			*s.value = value
+			s.commit(value)
 			return s, prevField
 		case key.Matches(msg, s.keymap.Next):
-			if s.selected >= len(s.filteredOptions) {
+			if s.selected >= len(s.filteredOptions) || !s.available(s.selected) {
+				break
+			}
+			if header := s.filteredOptions[s.selected]; header.header {
+				s.setCollapsed(!s.collapsedGroups[header.group])
 				break
 			}
 			value := s.filteredOptions[s.selected].Value
 			s.setFilter(false)
-			s.err = s.validate(value)
+			s.runValidation(value)
 			if s.err != nil {
 				return s, nil
 			}
-			*s.value = value
-			return s, nextField
+			if s.filteredOptions[s.selected].confirm != "" {
+				s.confirming = true
+				s.confirmOption = s.filteredOptions[s.selected]
+				return s, nil
+			}
+			return s, s.choose(value)
+		case !s.filtering && s.available(s.accelerated(msg)):
+			// Accelerators come last so that they never take the keys of
+			// other bindings.
+			s.selected = s.accelerated(msg)
+			value := s.filteredOptions[s.selected].Value
+			s.runValidation(value)
+			if s.err != nil {
+				return s, nil
+			}
+			if s.filteredOptions[s.selected].confirm != "" {
+				// Options asking for confirmation are only chosen once
+				// accepted, so without advancing the cursor just moves.
+				s.confirming = s.accelAdvance
+				s.confirmOption = s.filteredOptions[s.selected]
+				return s, nil
+			}
+			s.commit(value)
+			if s.accelAdvance {
+				return s, nextField
+			}
 		}
 
 		if s.filtering {
-			s.filteredOptions = s.options
-			if s.filter.Value() != "" {
-				s.filteredOptions = nil
-				for _, option := range s.options {
-					if s.filterFunc(option.Key) {
-						s.filteredOptions = append(s.filteredOptions, option)
-					}
-				}
-			}
-			if len(s.filteredOptions) > 0 {
-				s.selected = min(s.selected, len(s.filteredOptions)-1)
-			}
+			s.setFilteredOptions(s.matchingOptions())
 		}
 	}
 
+	s.scroll()
 	return s, cmd
 }
 
+// choose commits the value and moves on, which closes an open dropdown rather
+// than moving to the next field.
+func (s *Select[T]) choose(value T) tea.Cmd {
+	s.commit(value)
+	if s.dropdown && s.expanded {
+		s.expanded = false
+		return nil
+	}
+	return nextField
+}
+
+// reset moves the cursor back to the default option, or to the first option
+// without a default, clearing the filter. Like moving the cursor, the option
+// is chosen with Next.
+func (s *Select[T]) reset() {
+	s.filter.SetValue("")
+	s.filterSelected = nil
+	s.setFilter(false)
+	s.filteredOptions = s.visibleOptions
+	s.selected = 0
+	for i, option := range s.filteredOptions {
+		if s.isDefault(option) {
+			s.selected = i
+			break
+		}
+	}
+	s.scroll()
+}
+
+// clearFilter clears the filter, moving the cursor back to the option it was
+// on when the filter opened, or to the first option if that one is gone.
+func (s *Select[T]) clearFilter() {
+	s.filter.SetValue("")
+	s.setFilteredOptions(s.visibleOptions)
+	if s.filterSelected == nil {
+		return
+	}
+	s.selected = 0
+	for i, option := range s.filteredOptions {
+		if !option.header && equal(option.Value, *s.filterSelected) {
+			s.selected = i
+			break
+		}
+	}
+	s.filterSelected = nil
+}
+
+// setFilteredOptions sets the filtered options of the select field, keeping
+// the cursor on the same option if it is still present and moving it to the
+// first option otherwise.
+func (s *Select[T]) setFilteredOptions(options []Option[T]) {
+	if s.selected < len(s.filteredOptions) {
+		value := s.filteredOptions[s.selected].Value
+		s.selected = 0
+		for i, option := range options {
+			if equal(option.Value, value) {
+				s.selected = i
+				break
+			}
+		}
+	} else {
+		s.selected = 0
+	}
+	s.filteredOptions = options
+}
+
+// optionDescription returns the description of the filtered option at the given
+// index, if any.
+func (s *Select[T]) optionDescription(i int) string {
+	if i < 0 || i >= len(s.filteredOptions) {
+		return ""
+	}
+	return s.filteredOptions[i].description
+}
+
+// reservedLines returns the number of lines of all the visible options, or of
+// the tallest window of them when the select field has a height, as options
+// may span several lines.
+func (s *Select[T]) reservedLines() int {
+	window := len(s.visibleOptions)
+	if s.height > 0 {
+		window = min(s.height, window)
+	}
+	most, sum := 0, 0
+	for i, option := range s.visibleOptions {
+		sum += strings.Count(s.optionKey(option), "\n") + 1
+		if i >= window {
+			sum -= strings.Count(s.optionKey(s.visibleOptions[i-window]), "\n") + 1
+		}
+		most = max(most, sum)
+	}
+	return most
+}
+
+// pageSize returns the number of options to move by when paging.
+func (s *Select[T]) pageSize() int {
+	if s.height > 0 {
+		return s.height
+	}
+	return len(s.filteredOptions)
+}
+
+// scroll updates the offset of the visible options so that the cursor stays
+// in view.
+func (s *Select[T]) scroll() {
+	if s.height <= 0 || s.columns > 1 {
+		s.offset = 0
+		return
+	}
+	if s.selected < s.offset {
+		s.offset = s.selected
+	}
+	if s.selected >= s.offset+s.height {
+		s.offset = s.selected - s.height + 1
+	}
+	s.offset = clamp(s.offset, 0, max(len(s.filteredOptions)-s.height, 0))
+}
+
 // View renders the select field.
 func (s *Select[T]) View() string {
-	styles := s.theme.Blurred
-	if s.focused {
-		styles = s.theme.Focused
+	styles := s.theme.fieldStyles(s.focused, s.err)
+
+	if s.blurredSummary && !s.focused {
+		return s.summaryView(styles)
 	}
 
 	var sb strings.Builder
 	if s.filtering {
 		sb.WriteString(s.filter.View())
+		count := fmt.Sprintf(s.strings.FilterCount, len(s.filteredOptions), len(s.visibleOptions))
+		sb.WriteString(" " + styles.Description.Render(count))
 	} else if s.filter.Value() != "" {
 		sb.WriteString(styles.Title.Render(s.title) + styles.Description.Render("/"+s.filter.Value()))
 	} else {
 		sb.WriteString(styles.Title.Render(s.title))
 	}
+	if s.loading {
+		sb.WriteString(" " + styles.Description.Render(s.spinner.View()))
+	}
 	if s.err != nil {
 		sb.WriteString(styles.ErrorIndicator.String())
+	} else if s.warning != "" {
+		sb.WriteString(styles.WarningIndicator.String())
 	}
 	sb.WriteString("\n")
-	if s.description != "" {
-		sb.WriteString(styles.Description.Render(s.description) + "\n")
+	if s.description != "" && s.descVisibility.show(s.focused) {
+		sb.WriteString(styles.Description.Render(s.descScroll.view(s.description)) + "\n")
 	}
 
-	c := styles.SelectSelector.String()
-	for i, option := range s.filteredOptions {
-		if s.selected == i {
-			sb.WriteString(c + styles.SelectedOption.Render(option.Key))
+	if s.collapsed() {
+		sb.WriteString(s.dropdownView(styles))
+		return fieldBase(styles, s.noPadding).Render(sb.String())
+	}
+
+	if s.columns > 1 && len(s.filteredOptions) > 0 {
+		columns := s.columnsView(styles)
+		if s.confirming {
+			columns += "\n" + s.confirmView(styles)
+		}
+		sb.WriteString(s.boxList(styles, columns))
+		return fieldBase(styles, s.noPadding).Render(sb.String())
+	}
+
+	start, end := 0, len(s.filteredOptions)
+	if s.height > 0 {
+		start, end = s.offset, min(s.offset+s.height, len(s.filteredOptions))
+	}
+
+	var rows []string
+	if len(s.filteredOptions) <= 0 && s.filter.Value() != "" {
+		rows = append(rows, styles.placeholder().Render(fmt.Sprintf(s.strings.NoMatches, s.filter.Value())))
+	} else if len(s.filteredOptions) <= 0 {
+		rows = append(rows, styles.placeholder().Render(s.strings.NoOptions))
+	}
+	used := len(rows)
+	for i := start; i < end; i++ {
+		option := s.optionView(styles, i)
+		used += lipgloss.Height(option)
+		rows = append(rows, option)
+		if s.tooltip && s.selected == i {
+			rows = append(rows, s.tooltipView(styles))
+		}
+		if s.confirming && s.selected == i {
+			rows = append(rows, s.confirmView(styles))
+		}
+	}
+
+	// Reserve the lines the options take up so that the field keeps its
+	// height while filtering.
+	var list strings.Builder
+	list.WriteString(strings.Join(rows, "\n"))
+	for i := used; i < s.reservedLines(); i++ {
+		list.WriteString("\n")
+	}
+
+	switch {
+	case s.scrollbar && s.height > 0 && len(s.filteredOptions) > s.height:
+		sb.WriteString(s.boxList(styles, s.withScrollbar(styles, list.String())))
+	case !s.scrollbar && s.height > 0 && len(s.visibleOptions) > s.height:
+		selector := styles.SelectSelector.String()
+		sb.WriteString(s.boxList(styles, moreView(styles.MoreAbove, selector, start > 0)+"\n"+
+			list.String()+"\n"+moreView(styles.MoreBelow, selector, end < len(s.filteredOptions))))
+	default:
+		sb.WriteString(s.boxList(styles, list.String()))
+	}
+
+	return fieldBase(styles, s.noPadding).Render(sb.String())
+}
+
+// moreView renders the arrow showing that there are more options above or
+// below the visible ones, aligned with the options after the selector. It is
+// a blank line when there aren't, so that the list keeps its height.
+func moreView(arrow lipgloss.Style, selector string, more bool) string {
+	if !more {
+		return ""
+	}
+	return strings.Repeat(" ", lipgloss.Width(selector)) + arrow.String()
+}
+
+// withScrollbar renders the scrollbar of the visible window to the right of
+// the options, at the right edge of the field if it has a width.
+func (s *Select[T]) withScrollbar(styles FieldStyles, list string) string {
+	total, lines := len(s.filteredOptions), s.reservedLines()
+	thumb := max(lines*s.height/total, 1)
+	position := s.offset * (lines - thumb) / (total - s.height)
+
+	bar := make([]string, lines)
+	for i := range bar {
+		if i >= position && i < position+thumb {
+			bar[i] = styles.ScrollbarThumb.String()
 		} else {
-			sb.WriteString(strings.Repeat(" ", lipgloss.Width(c)) + styles.Option.Render(option.Key))
+			bar[i] = styles.ScrollbarTrack.String()
 		}
-		if i < len(s.options)-1 {
-			sb.WriteString("\n")
+	}
+
+	width := lipgloss.Width(list) + 1
+	if s.width > 0 {
+		width = max(s.listWidth(styles)-lipgloss.Width(bar[0]), 0)
+	}
+	list = lipgloss.NewStyle().Width(width).Render(list)
+	return lipgloss.JoinHorizontal(lipgloss.Top, list, strings.Join(bar, "\n"))
+}
+
+// boxList draws the options of the select field in their box, if it has a
+// bordered list.
+func (s *Select[T]) boxList(styles FieldStyles, list string) string {
+	if !s.bordered {
+		return list
+	}
+	style := s.listBorder(styles)
+	if width := s.listWidth(styles); width > 0 {
+		style = style.Width(width + style.GetHorizontalPadding())
+	}
+	return style.Render(list)
+}
+
+// optionView renders the filtered option at the given index.
+func (s *Select[T]) optionView(styles FieldStyles, i int) string {
+	option := s.filteredOptions[i]
+	c := styles.SelectSelector.String()
+	if option.header {
+		return s.headerView(styles, i)
+	}
+
+	var sb strings.Builder
+	if s.selected == i {
+		sb.WriteString(c)
+	} else {
+		sb.WriteString(strings.Repeat(" ", lipgloss.Width(c)))
+	}
+	if s.numbering {
+		sb.WriteString(s.numberView(styles, i))
+	}
+	style := styles.Option
+	if s.selected == i {
+		style = styles.SelectedOption
+	}
+
+	// The lines of multi-line options are aligned after the selector.
+	indent := "\n" + strings.Repeat(" ", lipgloss.Width(sb.String()))
+	if s.renderer != nil {
+		sb.WriteString(strings.ReplaceAll(s.renderer(option, s.selected == i, s.focused), "\n", indent))
+		return sb.String()
+	}
+	status, checked := s.statuses[option.index]
+	if checked && !option.header && !(status.checked && status.Available) {
+		style = styles.Description
+	}
+	text := acceleratorView(style, styles.Accelerator, s.optionKey(option), option.accelerator)
+	sb.WriteString(strings.ReplaceAll(text, "\n", indent))
+	if s.isDefault(option) {
+		sb.WriteString(" " + styles.DefaultOption.Render(s.strings.Default))
+	}
+	switch {
+	case checked && !status.checked:
+		sb.WriteString(" " + styles.Description.Render(s.spinner.View()))
+	case checked && !status.Available && status.Reason != "":
+		sb.WriteString(" " + styles.Description.Render(status.Reason))
+	}
+	return sb.String()
+}
+
+// headerView renders the header of a group of options at the given index,
+// counting the options of collapsed groups.
+func (s *Select[T]) headerView(styles FieldStyles, i int) string {
+	header := s.filteredOptions[i]
+	c := styles.SelectSelector.String()
+	if s.selected != i {
+		c = strings.Repeat(" ", lipgloss.Width(c))
+	}
+	if s.collapsedGroups[header.group] {
+		return c + styles.Title.Render(fmt.Sprintf("▸ %s (%d)", header.Key, s.groupSize(header.group)))
+	}
+	return c + styles.Title.Render("▾ "+header.Key)
+}
+
+// acceleratorView renders the text of an option, marking the first occurrence
+// of its accelerator, if any.
+func acceleratorView(style, accelerator lipgloss.Style, text string, r rune) string {
+	i := strings.IndexFunc(text, func(c rune) bool {
+		return r != 0 && unicode.ToLower(c) == unicode.ToLower(r)
+	})
+	if i < 0 {
+		return style.Render(text)
+	}
+	size := utf8.RuneLen([]rune(text[i:])[0])
+	return style.Render(text[:i]) +
+		style.Copy().Inherit(accelerator).Render(text[i:i+size]) +
+		style.Render(text[i+size:])
+}
+
+// tooltipView renders the tooltip of the option under the cursor.
+func (s *Select[T]) tooltipView(styles FieldStyles) string {
+	c := styles.SelectSelector.String()
+	return strings.Repeat(" ", lipgloss.Width(c)) + styles.Tooltip.Render(s.optionDescription(s.selected))
+}
+
+// confirmView renders the confirmation asked before moving on from the option
+// under the cursor.
+func (s *Select[T]) confirmView(styles FieldStyles) string {
+	c := styles.SelectSelector.String()
+	message := fmt.Sprintf(s.strings.ConfirmOption, s.confirmOption.confirm)
+	return strings.Repeat(" ", lipgloss.Width(c)) + styles.WarningMessage.Render(message)
+}
+
+// rows returns the number of rows needed to flow the given number of options
+// into the columns of the select field.
+func (s *Select[T]) rows(options int) int {
+	return max((options+s.columns-1)/s.columns, 1)
+}
+
+// columnsView renders the filtered options flowed into columns, reserving the
+// rows of all options so that the field keeps its height while filtering.
+func (s *Select[T]) columnsView(styles FieldStyles) string {
+	const gap = 2
+	rows := s.rows(len(s.filteredOptions))
+
+	var columns []string
+	for start := 0; start < len(s.filteredOptions); start += rows {
+		var column []string
+		for i := start; i < min(start+rows, len(s.filteredOptions)); i++ {
+			column = append(column, s.optionView(styles, i))
 		}
+		columns = append(columns, lipgloss.NewStyle().PaddingRight(gap).Render(strings.Join(column, "\n")))
 	}
 
-	for i := len(s.filteredOptions); i < len(s.options)-1; i++ {
-		sb.WriteString("\n")
+	view := lipgloss.JoinHorizontal(lipgloss.Top, columns...)
+	if s.tooltip {
+		view += "\n" + s.tooltipView(styles)
 	}
+	if lines := s.rows(len(s.visibleOptions)) - rows; lines > 0 {
+		view += strings.Repeat("\n", lines)
+	}
+	return view
+}
 
-	return styles.Base.Render(sb.String())
+// summaryView renders the select field as a single line with its title and
+// value, falling back to the option under the cursor when no option matches
+// the value.
+func (s *Select[T]) summaryView(styles FieldStyles) string {
+	value := s.DisplayValue()
+	if value == "" && s.selected < len(s.filteredOptions) {
+		value = s.optionKey(s.filteredOptions[s.selected])
+	}
+	var sb strings.Builder
+	sb.WriteString(styles.Title.Render(s.title+":") + " " + styles.SelectedOption.Render(value))
+	if s.err != nil {
+		sb.WriteString(styles.ErrorIndicator.String())
+	} else if s.warning != "" {
+		sb.WriteString(styles.WarningIndicator.String())
+	}
+	return fieldBase(styles, s.noPadding).Render(sb.String())
+}
+
+// dropdownView renders a closed dropdown, showing the chosen option.
+func (s *Select[T]) dropdownView(styles FieldStyles) string {
+	value := s.DisplayValue()
+	if value == "" && s.selected < len(s.filteredOptions) {
+		value = s.optionKey(s.filteredOptions[s.selected])
+	}
+	return styles.SelectSelector.String() + styles.SelectedOption.Render(value+" ▾")
 }
 
 // setFilter sets the filter of the select field.
@@ -276,26 +1757,59 @@ func (s *Select[T]) Run() error {
 
 // runAccessible runs an accessible select field.
 func (s *Select[T]) runAccessible() error {
+	if s.optionsFunc != nil {
+		s.setOptions(s.optionsFunc())
+	}
+	s.updateVisibility()
+	options := s.visibleOptions
+
 	var sb strings.Builder
 
 	sb.WriteString(s.theme.Focused.Title.Render(s.title) + "\n")
 
-	for i, option := range s.options {
-		sb.WriteString(fmt.Sprintf("%d. %s", i+1, option.Key))
+	for i, option := range options {
+		sb.WriteString(s.strings.formatOption(i, s.optionKey(option)))
+		if s.isDefault(option) {
+			sb.WriteString(" " + s.strings.Default)
+		}
+		if option.description != "" {
+			sb.WriteString(" - " + option.description)
+		}
 		sb.WriteString("\n")
 	}
 
-	fmt.Println(s.theme.Blurred.Base.Render(sb.String()))
+	if len(options) <= 0 {
+		sb.WriteString(s.strings.NoOptions + "\n")
+	}
+
+	fmt.Println(accessibleBlock(s.theme.Blurred.Base, sb.String(), s.width))
+
+	// There is nothing to choose from.
+	if len(options) <= 0 {
+		return nil
+	}
 
 	for {
-		choice := accessibility.PromptInt("Choose: ", 1, len(s.options))
-		option := s.options[choice-1]
-		if err := s.validate(option.Value); err != nil {
-			fmt.Println(err.Error())
+		choice, err := promptChoice(s.strings, s.strings.Choose, 1, len(options))
+		if err != nil {
+			return err
+		}
+		option := options[choice-1]
+		if err := s.validateChoice(option); err != nil {
+			fmt.Println(s.strings.reprompt(err))
 			continue
 		}
-		fmt.Println(s.theme.Focused.SelectedOption.Render("Chose: " + option.Key + "\n"))
-		*s.value = option.Value
+		if option.confirm != "" {
+			ok, err := promptBool(s.strings, fmt.Sprintf(s.strings.ConfirmOption, option.confirm)+": ")
+			if err != nil {
+				return err
+			}
+			if !ok {
+				continue
+			}
+		}
+		fmt.Println(s.theme.Focused.SelectedOption.Render(s.strings.Chose + s.optionKey(option) + "\n"))
+		s.commit(option.Value)
 		break
 	}
 
@@ -313,6 +1827,11 @@ func (s *Select[T]) WithTheme(theme *Theme) Field {
 // WithKeyMap sets the keymap on a select field.
 func (s *Select[T]) WithKeyMap(k *KeyMap) Field {
 	s.keymap = &k.Select
+	if len(s.remaps) > 0 {
+		keymap := k.Select
+		remapKeys(keymap.bindings(), s.remaps)
+		s.keymap = &keymap
+	}
 	return s
 }
 
@@ -322,12 +1841,62 @@ func (s *Select[T]) WithAccessible(accessible bool) Field {
 	return s
 }
 
+// WithStrings sets the user-facing strings of the select field.
+func (s *Select[T]) WithStrings(strings *Strings) Field {
+	s.strings = strings
+	return s
+}
+
 // WithWidth sets the width of the select field.
 func (s *Select[T]) WithWidth(width int) Field {
 	s.width = width
 	return s
 }
 
+// WithoutPadding sets whether the select field is rendered without the borders
+// and padding of its theme.
+func (s *Select[T]) WithoutPadding(v bool) Field {
+	s.noPadding = v
+	return s
+}
+
+// WithDescriptionVisibility sets when the description of the select field is
+// shown.
+func (s *Select[T]) WithDescriptionVisibility(v DescriptionVisibility) Field {
+	s.descVisibility = v
+	return s
+}
+
+// WithDescriptionHeight limits the description of the select field to the
+// given number of lines.
+func (s *Select[T]) WithDescriptionHeight(height int) Field {
+	s.descScroll.height = height
+	return s
+}
+
+// scrollDescription scrolls the description of the select field.
+func (s *Select[T]) scrollDescription(delta int) {
+	s.descScroll.scroll(s.description, delta)
+}
+
+// WithUpdateHook sets the update hook of the select field, see
+// Input.WithUpdateHook.
+func (s *Select[T]) WithUpdateHook(hook func(tea.Msg) tea.Msg) Field {
+	s.updateHook = hook
+	return s
+}
+
+// withRecover sets whether the select field recovers from panics in its
+// callbacks.
+func (s *Select[T]) withRecover(v bool) {
+	s.failFast = !v
+}
+
+// withValidationMode sets when the select field validates its value.
+func (s *Select[T]) withValidationMode(mode ValidationMode) {
+	s.validationMode = mode
+}
+
 // GetKey returns the key of the field.
 func (s *Select[T]) GetKey() string {
 	return s.key
@@ -337,3 +1906,19 @@ func (s *Select[T]) GetKey() string {
 func (s *Select[T]) GetValue() any {
 	return *s.value
 }
+
+// GetTitle returns the title of the field.
+func (s *Select[T]) GetTitle() string {
+	return s.title
+}
+
+// DisplayValue returns the key of the option matching the field's value, as
+// formatted by FormatOption.
+func (s *Select[T]) DisplayValue() string {
+	for _, option := range s.options {
+		if equal(option.Value, *s.value) {
+			return s.optionKey(option)
+		}
+	}
+	return ""
+}
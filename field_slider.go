@@ -0,0 +1,514 @@
+package huh
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// sliderWidth is the width of a slider's track when the field has no width.
+const sliderWidth = 24
+
+// Slider is a form field for choosing a number within a range, in steps.
+type Slider struct {
+	value *float64
+	key   string
+
+	// customization
+	title       string
+	description string
+	titleFunc   titleFunc
+	unit        string
+
+	// range
+	min     float64
+	max     float64
+	step    float64
+	bigStep float64
+
+	// error handling
+	validate func(float64) error
+	err      error
+	setErr   setError
+	warn     func(float64) string
+	warning  string
+
+	// state
+	focused bool
+
+	// options
+	width          int
+	tabIndex       int
+	accessible     bool
+	updateHook     func(tea.Msg) tea.Msg
+	failFast       bool
+	validationMode ValidationMode
+	noPadding      bool
+	descVisibility DescriptionVisibility
+	descScroll     descriptionScroll
+	theme          *Theme
+	keymap         *SliderKeyMap
+	strings        *Strings
+}
+
+// NewSlider returns a new slider field, ranging from 0 to 100 in steps of 1.
+func NewSlider() *Slider {
+	return withDefaults(&Slider{
+		value:    new(float64),
+		max:      100,
+		step:     1,
+		validate: func(float64) error { return nil },
+		warn:     func(float64) string { return "" },
+		strings:  DefaultStrings(),
+	})
+}
+
+// Value sets the value of the slider field.
+func (s *Slider) Value(value *float64) *Slider {
+	s.value = value
+	return s
+}
+
+// setValue sets the value of the slider field.
+func (s *Slider) setValue(value any) error {
+	v, err := convert[float64](value)
+	if err != nil {
+		return err
+	}
+	*s.value = v
+	return nil
+}
+
+// Key sets the key of the slider field.
+func (s *Slider) Key(key string) *Slider {
+	s.key = key
+	return s
+}
+
+// Title sets the title of the slider field.
+func (s *Slider) Title(title string) *Slider {
+	s.title = title
+	return s
+}
+
+// TitleFunc sets a function computing the title of the slider field, which
+// is recomputed when the value bindings points to changes.
+func (s *Slider) TitleFunc(f func() string, bindings any) *Slider {
+	s.titleFunc = titleFunc{f: f, bindings: bindings}
+	s.titleFunc.update(&s.title)
+	return s
+}
+
+// updateBindings recomputes the title of the slider field if the value of
+// its bindings changed.
+func (s *Slider) updateBindings() tea.Cmd {
+	s.titleFunc.update(&s.title)
+	return nil
+}
+
+// Description sets the description of the slider field.
+func (s *Slider) Description(description string) *Slider {
+	s.description = description
+	return s
+}
+
+// Range sets the minimum and maximum values of the slider field.
+func (s *Slider) Range(min, max float64) *Slider {
+	s.min, s.max = min, max
+	return s
+}
+
+// Step sets the step the slider field moves by, which can be fractional,
+// such as 0.5. Values snap to steps from the minimum.
+func (s *Slider) Step(step float64) *Slider {
+	if step > 0 {
+		s.step = step
+	}
+	return s
+}
+
+// BigStep sets the larger step the slider field moves by with the keymap's
+// DecreaseMore and IncreaseMore bindings. It defaults to a tenth of the
+// range, in whole steps.
+func (s *Slider) BigStep(step float64) *Slider {
+	s.bigStep = step
+	return s
+}
+
+// Unit sets the unit the value of the slider field is shown with, such
+// as "GB".
+func (s *Slider) Unit(unit string) *Slider {
+	s.unit = unit
+	return s
+}
+
+// Validate sets the validation function of the slider field. Values outside
+// of the range are always invalid.
+func (s *Slider) Validate(validate func(float64) error) *Slider {
+	s.validate = validate
+	return s
+}
+
+// Error returns the error of the slider field.
+func (s *Slider) Error() error {
+	return s.err
+}
+
+// Warn sets the warning function of the slider field.
+//
+// Warnings are shown like errors but don't prevent the user from moving on,
+// which is useful for unusual but allowed values. A warning is returned as a
+// non-empty string.
+func (s *Slider) Warn(warn func(float64) string) *Slider {
+	s.warn = warn
+	return s
+}
+
+// Warning returns the warning of the slider field.
+func (s *Slider) Warning() string {
+	return s.warning
+}
+
+// SetError sets an error on the slider field, which is shown until it is
+// cleared with nil or the value changes.
+func (s *Slider) SetError(err error) {
+	s.err = err
+	s.setErr = setError{err: err, value: *s.value}
+}
+
+// check checks whether the value is within the range before validating it.
+func (s *Slider) check(value float64) error {
+	if value < s.min || value > s.max {
+		return fmt.Errorf(s.strings.OutOfRange, s.format(s.min), s.format(s.max))
+	}
+	return s.validate(value)
+}
+
+// validateField validates the value of the slider field.
+func (s *Slider) validateField() {
+	s.runValidation(*s.value)
+}
+
+// runValidation validates the value, setting the error and warning of the
+// slider field.
+func (s *Slider) runValidation(value float64) {
+	if s.validationMode == ValidationOnSubmit {
+		return
+	}
+	defer recoverAsError(!s.failFast, &s.err)
+	s.err = s.setErr.get(value)
+	if s.err == nil {
+		s.err = s.check(value)
+	}
+	s.warning = s.warn(value)
+}
+
+// TabIndex sets the position of the slider field in its group's focus
+// order, see Group for how tab indices are ordered.
+func (s *Slider) TabIndex(index int) *Slider {
+	s.tabIndex = index
+	return s
+}
+
+// getTabIndex returns the tab index of the slider field.
+func (s *Slider) getTabIndex() int {
+	return s.tabIndex
+}
+
+// Focus focuses the slider field.
+func (s *Slider) Focus() tea.Cmd {
+	s.focused = true
+	return nil
+}
+
+// Blur blurs the slider field.
+func (s *Slider) Blur() tea.Cmd {
+	s.focused = false
+	s.runValidation(*s.value)
+	return nil
+}
+
+// KeyBinds returns the help message for the slider field.
+func (s *Slider) KeyBinds() []key.Binding {
+	return []key.Binding{
+		s.keymap.Decrease, s.keymap.Increase,
+		s.keymap.DecreaseMore, s.keymap.IncreaseMore,
+		s.keymap.Next, s.keymap.Prev,
+	}
+}
+
+// Init initializes the slider field.
+func (s *Slider) Init() tea.Cmd {
+	s.titleFunc.update(&s.title)
+	// A bound value outside of the range starts at the nearest end.
+	*s.value = math.Max(s.min, math.Min(*s.value, s.max))
+	return nil
+}
+
+// Update updates the slider field.
+func (s *Slider) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg = runUpdateHook(s.updateHook, msg); msg == nil {
+		return s, nil
+	}
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		s.err = nil
+		defer func() { s.setErr.keep(&s.err, *s.value) }()
+		s.warning = ""
+
+		switch {
+		case key.Matches(msg, s.keymap.Decrease):
+			s.move(-s.step)
+		case key.Matches(msg, s.keymap.Increase):
+			s.move(s.step)
+		case key.Matches(msg, s.keymap.DecreaseMore):
+			s.move(-s.largeStep())
+		case key.Matches(msg, s.keymap.IncreaseMore):
+			s.move(s.largeStep())
+		case key.Matches(msg, s.keymap.Prev):
+			s.runValidation(*s.value)
+			if s.err != nil {
+				return s, nil
+			}
+			return s, prevField
+		case key.Matches(msg, s.keymap.Next):
+			s.runValidation(*s.value)
+			if s.err != nil {
+				return s, nil
+			}
+			return s, nextField
+		}
+	}
+
+	return s, nil
+}
+
+// largeStep returns the big step of the slider field, or a tenth of its
+// range in whole steps.
+func (s *Slider) largeStep() float64 {
+	if s.bigStep > 0 {
+		return s.bigStep
+	}
+	return math.Max(math.Round((s.max-s.min)/10/s.step), 1) * s.step
+}
+
+// move moves the value of the slider field by delta, snapped to a step and
+// kept within the range.
+func (s *Slider) move(delta float64) {
+	*s.value = s.snap(*s.value + delta)
+}
+
+// snap returns the step closest to value, within the range.
+func (s *Slider) snap(value float64) float64 {
+	value = s.min + math.Round((value-s.min)/s.step)*s.step
+	value = math.Max(s.min, math.Min(value, s.max))
+	// Drop the floating point noise of fractional steps.
+	v, _ := strconv.ParseFloat(s.formatNumber(value), 64)
+	return v
+}
+
+// decimals returns the number of decimals of the step of the slider field.
+func (s *Slider) decimals() int {
+	step := strconv.FormatFloat(s.step, 'f', -1, 64)
+	if i := strings.IndexByte(step, '.'); i >= 0 {
+		return len(step) - i - 1
+	}
+	return 0
+}
+
+// formatNumber formats a value with at most the decimals of the step.
+func (s *Slider) formatNumber(value float64) string {
+	number := strconv.FormatFloat(value, 'f', s.decimals(), 64)
+	if strings.Contains(number, ".") {
+		number = strings.TrimRight(strings.TrimRight(number, "0"), ".")
+	}
+	return number
+}
+
+// format formats a value with the decimals of the step and the unit.
+func (s *Slider) format(value float64) string {
+	if s.unit == "" {
+		return s.formatNumber(value)
+	}
+	return s.formatNumber(value) + " " + s.unit
+}
+
+// View renders the slider field.
+func (s *Slider) View() string {
+	styles := s.theme.fieldStyles(s.focused, s.err)
+
+	var sb strings.Builder
+	sb.WriteString(styles.Title.Render(s.title))
+	if s.err != nil {
+		sb.WriteString(styles.ErrorIndicator.String())
+	} else if s.warning != "" {
+		sb.WriteString(styles.WarningIndicator.String())
+	}
+	if s.description != "" && s.descVisibility.show(s.focused) {
+		sb.WriteString("\n")
+		sb.WriteString(styles.Description.Render(s.descScroll.view(s.description)))
+	}
+	sb.WriteString("\n")
+	sb.WriteString(s.trackView(styles))
+	sb.WriteString(" ")
+	sb.WriteString(styles.Option.Render(s.format(*s.value)))
+	return fieldBase(styles, s.noPadding).Render(sb.String())
+}
+
+// trackView renders the track of the slider field with its thumb at the
+// position of the value.
+func (s *Slider) trackView(styles FieldStyles) string {
+	width := sliderWidth
+	if s.width > 0 {
+		// The label takes the width of the widest value.
+		label := max(lipgloss.Width(s.format(s.min)), lipgloss.Width(s.format(s.max))) + 1
+		width = max(s.width-styles.Base.GetHorizontalFrameSize()-label, 2)
+	}
+
+	pos := 0
+	if s.max > s.min {
+		ratio := (math.Max(s.min, math.Min(*s.value, s.max)) - s.min) / (s.max - s.min)
+		pos = int(math.Round(ratio * float64(width-1)))
+	}
+	track := styles.SliderTrack.Copy().UnsetString()
+	fill := styles.SliderTrack.Value()
+	return track.Render(strings.Repeat(fill, pos)) +
+		styles.SliderThumb.String() +
+		track.Render(strings.Repeat(fill, width-pos-1))
+}
+
+// Run runs the slider field.
+func (s *Slider) Run() error {
+	if s.accessible {
+		return s.runAccessible()
+	}
+	return Run(s)
+}
+
+// runAccessible runs the slider field in accessible mode, prompting for a
+// number shown with the unit.
+func (s *Slider) runAccessible() error {
+	fmt.Println(accessibleBlock(s.theme.Blurred.Base, s.theme.Focused.Title.Render(s.title), s.width))
+	fmt.Println()
+	prompt := fmt.Sprintf(s.strings.NumberPrompt, s.format(s.min), s.format(s.max))
+	input, err := promptString(s.strings, prompt, func(input string) error {
+		value, err := strconv.ParseFloat(strings.TrimSpace(input), 64)
+		if err != nil {
+			return errors.New(s.strings.InvalidInput)
+		}
+		if value < s.min || value > s.max {
+			return s.check(value)
+		}
+		return s.check(s.snap(value))
+	})
+	if err != nil {
+		return err
+	}
+	// Answers snap to the closest step, as moving the slider does.
+	value, _ := strconv.ParseFloat(strings.TrimSpace(input), 64)
+	*s.value = s.snap(value)
+	fmt.Println(s.theme.Focused.SelectedOption.Render(s.strings.Chose+s.DisplayValue()) + "\n")
+	return nil
+}
+
+// WithTheme sets the theme of the slider field.
+func (s *Slider) WithTheme(theme *Theme) Field {
+	s.theme = theme
+	return s
+}
+
+// WithKeyMap sets the keymap of the slider field.
+func (s *Slider) WithKeyMap(k *KeyMap) Field {
+	s.keymap = &k.Slider
+	return s
+}
+
+// WithAccessible sets the accessible mode of the slider field.
+func (s *Slider) WithAccessible(accessible bool) Field {
+	s.accessible = accessible
+	return s
+}
+
+// WithStrings sets the user-facing strings of the slider field.
+func (s *Slider) WithStrings(strings *Strings) Field {
+	s.strings = strings
+	return s
+}
+
+// WithWidth sets the width of the slider field.
+func (s *Slider) WithWidth(width int) Field {
+	s.width = width
+	return s
+}
+
+// WithoutPadding sets whether the slider field is rendered without the
+// borders and padding of its theme.
+func (s *Slider) WithoutPadding(v bool) Field {
+	s.noPadding = v
+	return s
+}
+
+// WithDescriptionVisibility sets when the description of the slider field is
+// shown.
+func (s *Slider) WithDescriptionVisibility(v DescriptionVisibility) Field {
+	s.descVisibility = v
+	return s
+}
+
+// WithDescriptionHeight limits the description of the slider field to the
+// given number of lines.
+func (s *Slider) WithDescriptionHeight(height int) Field {
+	s.descScroll.height = height
+	return s
+}
+
+// scrollDescription scrolls the description of the slider field.
+func (s *Slider) scrollDescription(delta int) {
+	s.descScroll.scroll(s.description, delta)
+}
+
+// WithUpdateHook sets the update hook of the slider field, see
+// Input.WithUpdateHook.
+func (s *Slider) WithUpdateHook(hook func(tea.Msg) tea.Msg) Field {
+	s.updateHook = hook
+	return s
+}
+
+// withRecover sets whether the slider field recovers from panics in its
+// callbacks.
+func (s *Slider) withRecover(v bool) {
+	s.failFast = !v
+}
+
+// withValidationMode sets when the slider field validates its value.
+func (s *Slider) withValidationMode(mode ValidationMode) {
+	s.validationMode = mode
+}
+
+// GetKey returns the key of the field.
+func (s *Slider) GetKey() string {
+	return s.key
+}
+
+// GetValue returns the value of the field.
+func (s *Slider) GetValue() any {
+	return *s.value
+}
+
+// GetTitle returns the title of the field.
+func (s *Slider) GetTitle() string {
+	return s.title
+}
+
+// DisplayValue returns the value of the field with its unit, such as
+// "4.5 GB".
+func (s *Slider) DisplayValue() string {
+	return s.format(*s.value)
+}
@@ -1,15 +1,16 @@
 package huh
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"os/exec"
 	"strings"
 
+	"github.com/charmbracelet/bubbles/cursor"
 	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/textarea"
 	tea "github.com/charmbracelet/bubbletea"
-	"github.com/charmbracelet/huh/accessibility"
 	"github.com/charmbracelet/lipgloss"
 )
 
@@ -19,8 +20,12 @@ type Text struct {
 	key   string
 
 	// error handling
-	validate func(string) error
-	err      error
+	validate   func(string) error
+	requiredIf func() bool
+	err        error
+	setErr     setError
+	warn       func(string) string
+	warning    string
 
 	// model
 	textarea textarea.Model
@@ -28,18 +33,31 @@ type Text struct {
 	// customization
 	title           string
 	description     string
+	titleFunc       titleFunc
 	editorCmd       string
 	editorArgs      []string
 	editorExtension string
+	secret          bool
 
 	// state
 	focused bool
+	editing bool
 
 	// form options
-	width      int
-	accessible bool
-	theme      *Theme
-	keymap     *TextKeyMap
+	cursorModeSet  bool
+	width          int
+	tabIndex       int
+	accessible     bool
+	updateHook     func(tea.Msg) tea.Msg
+	failFast       bool
+	validationMode ValidationMode
+	noPadding      bool
+	descVisibility DescriptionVisibility
+	descScroll     descriptionScroll
+	theme          *Theme
+	keymap         *TextKeyMap
+	remaps         []keyRemap
+	strings        *Strings
 }
 
 // NewText returns a new text field.
@@ -55,12 +73,14 @@ func NewText() *Text {
 		value:           new(string),
 		textarea:        text,
 		validate:        func(string) error { return nil },
+		warn:            func(string) string { return "" },
+		strings:         DefaultStrings(),
 		editorCmd:       editorCmd,
 		editorArgs:      editorArgs,
 		editorExtension: "md",
 	}
 
-	return t
+	return withDefaults(t)
 }
 
 // Value sets the value of the text field.
@@ -70,6 +90,20 @@ func (t *Text) Value(value *string) *Text {
 	return t
 }
 
+// setValue sets the value of the text field.
+func (t *Text) setValue(value any) error {
+	if t.secret && value == Redacted {
+		return nil
+	}
+	v, err := convert[string](value)
+	if err != nil {
+		return err
+	}
+	*t.value = v
+	t.textarea.SetValue(v)
+	return nil
+}
+
 // Key sets the key of the text field.
 func (t *Text) Key(key string) *Text {
 	t.key = key
@@ -82,6 +116,21 @@ func (t *Text) Title(title string) *Text {
 	return t
 }
 
+// TitleFunc sets a function computing the title of the text field, which
+// is recomputed when the value bindings points to changes.
+func (t *Text) TitleFunc(f func() string, bindings any) *Text {
+	t.titleFunc = titleFunc{f: f, bindings: bindings}
+	t.titleFunc.update(&t.title)
+	return t
+}
+
+// updateBindings recomputes the title of the text field if the value of
+// its bindings changed.
+func (t *Text) updateBindings() tea.Cmd {
+	t.titleFunc.update(&t.title)
+	return nil
+}
+
 // Lines sets the number of lines to show of the text field.
 func (t *Text) Lines(lines int) *Text {
 	t.textarea.SetHeight(lines)
@@ -100,6 +149,19 @@ func (t *Text) CharLimit(charlimit int) *Text {
 	return t
 }
 
+// Secret sets whether the value of the text field is a secret, such as a
+// private key. Secret values are redacted from everything the form outputs,
+// see Redacted, while the bound value still holds the real value.
+func (t *Text) Secret(secret bool) *Text {
+	t.secret = secret
+	return t
+}
+
+// isSecret returns whether the value of the text field is a secret.
+func (t *Text) isSecret() bool {
+	return t.secret
+}
+
 // Placeholder sets the placeholder of the text field.
 func (t *Text) Placeholder(str string) *Text {
 	t.textarea.Placeholder = str
@@ -148,6 +210,113 @@ func (t *Text) Error() error {
 	return t.err
 }
 
+// Warn sets the warning function of the text field.
+//
+// Warnings are shown like errors but don't prevent the user from moving on,
+// which is useful for unusual but allowed values. A warning is returned as a
+// non-empty string.
+func (t *Text) Warn(warn func(string) string) *Text {
+	t.warn = warn
+	return t
+}
+
+// Warning returns the warning of the text field.
+func (t *Text) Warning() string {
+	return t.warning
+}
+
+// CursorMode sets whether the cursor of the text field blinks, stays static
+// or is hidden. Only the focused field shows a cursor.
+func (t *Text) CursorMode(mode cursor.Mode) *Text {
+	t.cursorModeSet = true
+	t.textarea.Cursor.SetMode(mode)
+	return t
+}
+
+// withCursorMode sets the cursor mode unless one was set on the field itself.
+func (t *Text) withCursorMode(mode cursor.Mode) {
+	if t.cursorModeSet {
+		return
+	}
+	t.textarea.Cursor.SetMode(mode)
+}
+
+// RemapKey replaces the key from with the keys to in the bindings of the
+// text field, on top of the keymap of its form, see KeyMap.Remap.
+func (t *Text) RemapKey(from string, to ...string) *Text {
+	t.remaps = append(t.remaps, keyRemap{from, to})
+	if t.keymap != nil {
+		keymap := *t.keymap
+		remapKeys(keymap.bindings(), t.remaps[len(t.remaps)-1:])
+		t.keymap = &keymap
+		t.textarea.KeyMap.InsertNewline.SetKeys(t.keymap.NewLine.Keys()...)
+	}
+	return t
+}
+
+// RequiredIf sets a predicate under which the text field can't be left
+// empty.
+//
+// The predicate is evaluated whenever the field is validated, which is when
+// the user moves on from it, so it can depend on the answers to previous
+// fields. Fields in hidden groups aren't validated.
+func (t *Text) RequiredIf(required func() bool) *Text {
+	t.requiredIf = required
+	return t
+}
+
+// missing reports whether the text field is required but still empty.
+func (t *Text) missing() bool {
+	return t.textarea.Value() == "" && t.requiredIf != nil && t.requiredIf()
+}
+
+// check checks whether the value is required before validating it.
+func (t *Text) check(value string) error {
+	if value == "" && t.requiredIf != nil && t.requiredIf() {
+		return errors.New(t.strings.Required)
+	}
+	return t.validate(value)
+}
+
+// SetError sets an error on the text field, which is shown until it is
+// cleared with nil or the value changes.
+func (t *Text) SetError(err error) {
+	t.err = err
+	t.setErr = setError{err: err, value: t.textarea.Value()}
+}
+
+// validateField stores and validates the value of the text field.
+func (t *Text) validateField() {
+	*t.value = t.textarea.Value()
+	t.runValidation(*t.value)
+}
+
+// runValidation validates the value, setting the error and warning of the
+// text field.
+func (t *Text) runValidation(value string) {
+	if t.validationMode == ValidationOnSubmit {
+		return
+	}
+	defer recoverAsError(!t.failFast, &t.err)
+	t.err = t.setErr.get(value)
+	if t.err == nil {
+		t.err = t.check(value)
+	}
+	t.warning = t.warn(value)
+}
+
+// TabIndex sets the position of the text field in its group's focus
+// order, see Group for how tab indices are ordered.
+func (t *Text) TabIndex(index int) *Text {
+	t.tabIndex = index
+	return t
+}
+
+// getTabIndex returns the tab index of the text field.
+func (t *Text) getTabIndex() int {
+	return t.tabIndex
+}
+
 // Focus focuses the text field.
 func (t *Text) Focus() tea.Cmd {
 	t.focused = true
@@ -159,10 +328,16 @@ func (t *Text) Blur() tea.Cmd {
 	t.focused = false
 	*t.value = t.textarea.Value()
 	t.textarea.Blur()
-	t.err = t.validate(*t.value)
+	t.runValidation(*t.value)
 	return nil
 }
 
+// enteringText returns whether the text field takes typed text. Text fields
+// always take text.
+func (t *Text) enteringText() bool {
+	return true
+}
+
 // KeyBinds returns the help message for the text field.
 func (t *Text) KeyBinds() []key.Binding {
 	return []key.Binding{t.keymap.Next, t.keymap.NewLine, t.keymap.Editor, t.keymap.Prev}
@@ -172,12 +347,17 @@ type updateValueMsg []byte
 
 // Init initializes the text field.
 func (t *Text) Init() tea.Cmd {
+	t.titleFunc.update(&t.title)
 	t.textarea.Blur()
 	return nil
 }
 
 // Update updates the text field.
 func (t *Text) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg = runUpdateHook(t.updateHook, msg); msg == nil {
+		return t, nil
+	}
+
 	var cmds []tea.Cmd
 	var cmd tea.Cmd
 
@@ -186,30 +366,38 @@ func (t *Text) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	switch msg := msg.(type) {
 	case updateValueMsg:
+		t.editing = false
 		t.textarea.SetValue(string(msg))
 	case tea.KeyMsg:
-		t.err = nil
+		t.err = t.setErr.get(t.textarea.Value())
+		t.warning = ""
+		if t.validationMode == ValidationInline {
+			t.runValidation(t.textarea.Value())
+		}
 
 		switch {
+		case typedText(msg):
+			// Typed text is never taken by a binding, see KeyMap.Conflicts.
 		case key.Matches(msg, t.keymap.Editor):
 			ext := strings.TrimPrefix(t.editorExtension, ".")
 			tmpFile, _ := os.CreateTemp(os.TempDir(), "*."+ext)
 			cmd := exec.Command(t.editorCmd, append(t.editorArgs, tmpFile.Name())...) //nolint:gosec
 			_ = os.WriteFile(tmpFile.Name(), []byte(t.textarea.Value()), os.ModePerm)
+			t.editing = true
 			cmds = append(cmds, tea.ExecProcess(cmd, func(error) tea.Msg {
 				content, _ := os.ReadFile(tmpFile.Name())
 				return updateValueMsg(content)
 			}))
 		case key.Matches(msg, t.keymap.Next):
 			value := t.textarea.Value()
-			t.err = t.validate(value)
+			t.runValidation(value)
 			if t.err != nil {
 				return t, nil
 			}
 			cmds = append(cmds, nextField)
 		case key.Matches(msg, t.keymap.Prev):
 			value := t.textarea.Value()
-			t.err = t.validate(value)
+			t.runValidation(value)
 			if t.err != nil {
 				return t, nil
 			}
@@ -220,24 +408,23 @@ func (t *Text) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return t, tea.Batch(cmds...)
 }
 
+// busy returns whether the text field is open in the editor.
+func (t *Text) busy() bool {
+	return t.editing
+}
+
 // View renders the text field.
 func (t *Text) View() string {
-	var (
-		styles         FieldStyles
-		textareaStyles *textarea.Style
-	)
+	styles := t.theme.fieldStyles(t.focused, t.err)
+	textareaStyles := &t.textarea.BlurredStyle
 	if t.focused {
-		styles = t.theme.Focused
 		textareaStyles = &t.textarea.FocusedStyle
-	} else {
-		styles = t.theme.Blurred
-		textareaStyles = &t.textarea.BlurredStyle
 	}
 
 	// NB: since the method is on a pointer receiver these are being mutated.
 	// Because this runs on every render this shouldn't matter in practice,
 	// however.
-	textareaStyles.Placeholder = styles.TextInput.Placeholder
+	textareaStyles.Placeholder = styles.placeholder()
 	textareaStyles.Text = styles.TextInput.Text
 	textareaStyles.Prompt = styles.TextInput.Prompt
 	textareaStyles.CursorLine = styles.TextInput.Text
@@ -248,16 +435,18 @@ func (t *Text) View() string {
 		sb.WriteString(styles.Title.Render(t.title))
 		if t.err != nil {
 			sb.WriteString(styles.ErrorIndicator.String())
+		} else if t.warning != "" {
+			sb.WriteString(styles.WarningIndicator.String())
 		}
 		sb.WriteString("\n")
 	}
-	if t.description != "" {
-		sb.WriteString(styles.Description.Render(t.description))
+	if t.description != "" && t.descVisibility.show(t.focused) {
+		sb.WriteString(styles.Description.Render(t.descScroll.view(t.description)))
 		sb.WriteString("\n")
 	}
 	sb.WriteString(t.textarea.View())
 
-	return styles.Base.Render(sb.String())
+	return fieldBase(styles, t.noPadding).Render(sb.String())
 }
 
 // Run runs the text field.
@@ -270,9 +459,13 @@ func (t *Text) Run() error {
 
 // runAccessible runs an accessible text field.
 func (t *Text) runAccessible() error {
-	fmt.Println(t.theme.Blurred.Base.Render(t.theme.Focused.Title.Render(t.title)))
+	fmt.Println(accessibleBlock(t.theme.Blurred.Base, t.theme.Focused.Title.Render(t.title), t.width))
 	fmt.Println()
-	*t.value = accessibility.PromptString("Input: ", t.validate)
+	value, err := promptString(t.strings, t.strings.Input, t.check)
+	if err != nil {
+		return err
+	}
+	*t.value = value
 	fmt.Println()
 	return nil
 }
@@ -286,6 +479,11 @@ func (t *Text) WithTheme(theme *Theme) Field {
 // WithKeyMap sets the keymap on a text field.
 func (t *Text) WithKeyMap(k *KeyMap) Field {
 	t.keymap = &k.Text
+	if len(t.remaps) > 0 {
+		keymap := k.Text
+		remapKeys(keymap.bindings(), t.remaps)
+		t.keymap = &keymap
+	}
 	t.textarea.KeyMap.InsertNewline.SetKeys(t.keymap.NewLine.Keys()...)
 	return t
 }
@@ -296,13 +494,63 @@ func (t *Text) WithAccessible(accessible bool) Field {
 	return t
 }
 
+// WithStrings sets the user-facing strings of the text field.
+func (t *Text) WithStrings(strings *Strings) Field {
+	t.strings = strings
+	return t
+}
+
 // WithWidth sets the width of the text field.
 func (t *Text) WithWidth(width int) Field {
 	t.width = width
-	t.textarea.SetWidth(width - t.theme.Blurred.Base.GetHorizontalFrameSize())
+	t.textarea.SetWidth(width - fieldBase(t.theme.Blurred, t.noPadding).GetHorizontalFrameSize())
+	return t
+}
+
+// WithoutPadding sets whether the text field is rendered without the borders
+// and padding of its theme.
+func (t *Text) WithoutPadding(v bool) Field {
+	t.noPadding = v
+	return t
+}
+
+// WithDescriptionVisibility sets when the description of the text field is
+// shown.
+func (t *Text) WithDescriptionVisibility(v DescriptionVisibility) Field {
+	t.descVisibility = v
+	return t
+}
+
+// WithDescriptionHeight limits the description of the text field to the
+// given number of lines.
+func (t *Text) WithDescriptionHeight(height int) Field {
+	t.descScroll.height = height
 	return t
 }
 
+// scrollDescription scrolls the description of the text field.
+func (t *Text) scrollDescription(delta int) {
+	t.descScroll.scroll(t.description, delta)
+}
+
+// WithUpdateHook sets the update hook of the text field, see
+// Input.WithUpdateHook.
+func (t *Text) WithUpdateHook(hook func(tea.Msg) tea.Msg) Field {
+	t.updateHook = hook
+	return t
+}
+
+// withRecover sets whether the text field recovers from panics in its
+// callbacks.
+func (t *Text) withRecover(v bool) {
+	t.failFast = !v
+}
+
+// withValidationMode sets when the text field validates its value.
+func (t *Text) withValidationMode(mode ValidationMode) {
+	t.validationMode = mode
+}
+
 // GetKey returns the key of the field.
 func (t *Text) GetKey() string {
 	return t.key
@@ -312,3 +560,17 @@ func (t *Text) GetKey() string {
 func (t *Text) GetValue() any {
 	return *t.value
 }
+
+// GetTitle returns the title of the field.
+func (t *Text) GetTitle() string {
+	return t.title
+}
+
+// DisplayValue returns the value of the field as it is displayed, redacted
+// when it is a secret.
+func (t *Text) DisplayValue() string {
+	if t.secret {
+		return Redacted
+	}
+	return *t.value
+}
@@ -0,0 +1,538 @@
+package huh
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// breadcrumbSeparator separates the levels of the breadcrumb of a tree field,
+// it also marks options with children.
+const breadcrumbSeparator = " › "
+
+// Tree is a form field to choose an option from a hierarchy of options, such
+// as categories and subcategories, one level at a time.
+//
+// Options with children, see Option.Children, are branches that open the
+// next level. The value of the field is the leaf option chosen.
+type Tree[T any] struct {
+	value *T
+	key   string
+
+	// customization
+	title       string
+	description string
+	titleFunc   titleFunc
+	options     []Option[T]
+
+	// error handling
+	validate func(T) error
+	err      error
+	setErr   setError
+	warn     func(T) string
+	warning  string
+
+	// state
+	path     []int
+	selected int
+	focused  bool
+
+	// options
+	width          int
+	tabIndex       int
+	accessible     bool
+	updateHook     func(tea.Msg) tea.Msg
+	failFast       bool
+	validationMode ValidationMode
+	noPadding      bool
+	descVisibility DescriptionVisibility
+	descScroll     descriptionScroll
+	theme          *Theme
+	keymap         *TreeKeyMap
+	strings        *Strings
+}
+
+// NewTree returns a new tree field.
+func NewTree[T any]() *Tree[T] {
+	return withDefaults(&Tree[T]{
+		value:    new(T),
+		validate: func(T) error { return nil },
+		warn:     func(T) string { return "" },
+		strings:  DefaultStrings(),
+	})
+}
+
+// Value sets the value of the tree field. If the value is one of the leaf
+// options, the tree opens at its level.
+func (t *Tree[T]) Value(value *T) *Tree[T] {
+	t.value = value
+	t.selectValue()
+	return t
+}
+
+// setValue sets the value of the tree field, opening the tree at its level.
+func (t *Tree[T]) setValue(value any) error {
+	v, err := convert[T](value)
+	if err != nil {
+		return err
+	}
+	*t.value = v
+	t.selectValue()
+	return nil
+}
+
+// Key sets the key of the tree field.
+func (t *Tree[T]) Key(key string) *Tree[T] {
+	t.key = key
+	return t
+}
+
+// Title sets the title of the tree field.
+func (t *Tree[T]) Title(title string) *Tree[T] {
+	t.title = title
+	return t
+}
+
+// TitleFunc sets a function computing the title of the tree field, which
+// is recomputed when the value bindings points to changes.
+func (t *Tree[T]) TitleFunc(f func() string, bindings any) *Tree[T] {
+	t.titleFunc = titleFunc{f: f, bindings: bindings}
+	t.titleFunc.update(&t.title)
+	return t
+}
+
+// updateBindings recomputes the title of the tree field if the value of
+// its bindings changed.
+func (t *Tree[T]) updateBindings() tea.Cmd {
+	t.titleFunc.update(&t.title)
+	return nil
+}
+
+// Description sets the description of the tree field.
+func (t *Tree[T]) Description(description string) *Tree[T] {
+	t.description = description
+	return t
+}
+
+// Options sets the top level options of the tree field.
+func (t *Tree[T]) Options(options ...Option[T]) *Tree[T] {
+	if len(options) <= 0 {
+		return t
+	}
+	t.options = options
+	t.path, t.selected = nil, 0
+	t.selectValue()
+	return t
+}
+
+// selectValue opens the tree at the leaf option matching the value, if any.
+func (t *Tree[T]) selectValue() {
+	if path := findLeaf(t.options, *t.value); path != nil {
+		t.path, t.selected = path[:len(path)-1], path[len(path)-1]
+	}
+}
+
+// findLeaf returns the path of indices to the leaf option with the given
+// value, or nil if there is none.
+func findLeaf[T any](options []Option[T], value T) []int {
+	for i, option := range options {
+		if len(option.children) > 0 {
+			if path := findLeaf(option.children, value); path != nil {
+				return append([]int{i}, path...)
+			}
+		} else if equal(option.Value, value) {
+			return []int{i}
+		}
+	}
+	return nil
+}
+
+// optionValues returns the values of the leaf options of the tree field.
+func (t *Tree[T]) optionValues() []string {
+	return jsonValues(leaves(t.options))
+}
+
+// leaves returns the leaf options of a hierarchy of options.
+func leaves[T any](options []Option[T]) []Option[T] {
+	var leafs []Option[T]
+	for _, option := range options {
+		if len(option.children) > 0 {
+			leafs = append(leafs, leaves(option.children)...)
+		} else {
+			leafs = append(leafs, option)
+		}
+	}
+	return leafs
+}
+
+// level returns the options of the open level.
+func (t *Tree[T]) level() []Option[T] {
+	options := t.options
+	for _, i := range t.path {
+		options = options[i].children
+	}
+	return options
+}
+
+// descend opens the level of the option under the cursor, returning false if
+// it has no children.
+func (t *Tree[T]) descend() bool {
+	options := t.level()
+	if t.selected >= len(options) || len(options[t.selected].children) <= 0 {
+		return false
+	}
+	t.path = append(t.path, t.selected)
+	t.selected = 0
+	return true
+}
+
+// ascend opens the parent level, keeping the cursor on the branch the user
+// came from.
+func (t *Tree[T]) ascend() {
+	if len(t.path) <= 0 {
+		return
+	}
+	t.selected = t.path[len(t.path)-1]
+	t.path = t.path[:len(t.path)-1]
+}
+
+// Validate sets the validation function of the tree field.
+func (t *Tree[T]) Validate(validate func(T) error) *Tree[T] {
+	t.validate = validate
+	return t
+}
+
+// Error returns the error of the tree field.
+func (t *Tree[T]) Error() error {
+	return t.err
+}
+
+// Warn sets the warning function of the tree field, see Select.Warn.
+func (t *Tree[T]) Warn(warn func(T) string) *Tree[T] {
+	t.warn = warn
+	return t
+}
+
+// Warning returns the warning of the tree field.
+func (t *Tree[T]) Warning() string {
+	return t.warning
+}
+
+// SetError sets an error on the tree field, which is shown until it is
+// cleared with nil or the value changes.
+func (t *Tree[T]) SetError(err error) {
+	t.err = err
+	t.setErr = setError{err: err, value: *t.value}
+}
+
+// validateField validates the value of the tree field.
+func (t *Tree[T]) validateField() {
+	t.runValidation(*t.value)
+}
+
+// runValidation validates the value, setting the error and warning of the
+// tree field.
+func (t *Tree[T]) runValidation(value T) {
+	if t.validationMode == ValidationOnSubmit {
+		return
+	}
+	defer recoverAsError(!t.failFast, &t.err)
+	t.err = t.setErr.get(value)
+	if t.err == nil {
+		t.err = t.validate(value)
+	}
+	t.warning = t.warn(value)
+}
+
+// TabIndex sets the position of the tree field in its group's focus order,
+// see Group for how tab indices are ordered.
+func (t *Tree[T]) TabIndex(index int) *Tree[T] {
+	t.tabIndex = index
+	return t
+}
+
+// getTabIndex returns the tab index of the tree field.
+func (t *Tree[T]) getTabIndex() int {
+	return t.tabIndex
+}
+
+// Focus focuses the tree field.
+func (t *Tree[T]) Focus() tea.Cmd {
+	t.focused = true
+	return nil
+}
+
+// Blur blurs the tree field.
+func (t *Tree[T]) Blur() tea.Cmd {
+	t.focused = false
+	t.runValidation(*t.value)
+	return nil
+}
+
+// KeyBinds returns the help keybindings for the tree field.
+func (t *Tree[T]) KeyBinds() []key.Binding {
+	binds := []key.Binding{t.keymap.Up, t.keymap.Down, t.keymap.Descend}
+	if len(t.path) > 0 {
+		binds = append(binds, t.keymap.Ascend)
+	}
+	return append(binds, t.keymap.Next, t.keymap.Prev)
+}
+
+// Init initializes the tree field.
+func (t *Tree[T]) Init() tea.Cmd {
+	t.titleFunc.update(&t.title)
+	return nil
+}
+
+// Update updates the tree field.
+func (t *Tree[T]) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg = runUpdateHook(t.updateHook, msg); msg == nil {
+		return t, nil
+	}
+
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		t.err = nil
+		defer func() { t.setErr.keep(&t.err, *t.value) }()
+		t.warning = ""
+		options := t.level()
+		switch {
+		case key.Matches(msg, t.keymap.Up):
+			t.selected = max(t.selected-1, 0)
+		case key.Matches(msg, t.keymap.Down):
+			t.selected = max(min(t.selected+1, len(options)-1), 0)
+		case key.Matches(msg, t.keymap.Descend):
+			t.descend()
+		case key.Matches(msg, t.keymap.Ascend):
+			t.ascend()
+		case key.Matches(msg, t.keymap.Prev):
+			return t, prevField
+		case key.Matches(msg, t.keymap.Next):
+			if t.selected >= len(options) || t.descend() {
+				break
+			}
+			value := options[t.selected].Value
+			t.runValidation(value)
+			if t.err != nil {
+				return t, nil
+			}
+			*t.value = value
+			return t, nextField
+		}
+	}
+	return t, nil
+}
+
+// breadcrumb returns the keys of the branches leading to the open level.
+func (t *Tree[T]) breadcrumb() []string {
+	var crumbs []string
+	options := t.options
+	for _, i := range t.path {
+		crumbs = append(crumbs, options[i].Key)
+		options = options[i].children
+	}
+	return crumbs
+}
+
+// View renders the tree field.
+func (t *Tree[T]) View() string {
+	styles := t.theme.fieldStyles(t.focused, t.err)
+
+	var sb strings.Builder
+	sb.WriteString(styles.Title.Render(t.title))
+	if t.err != nil {
+		sb.WriteString(styles.ErrorIndicator.String())
+	} else if t.warning != "" {
+		sb.WriteString(styles.WarningIndicator.String())
+	}
+	sb.WriteString("\n")
+	if t.description != "" && t.descVisibility.show(t.focused) {
+		sb.WriteString(styles.Description.Render(t.descScroll.view(t.description)) + "\n")
+	}
+	if crumbs := t.breadcrumb(); len(crumbs) > 0 {
+		sb.WriteString(styles.Breadcrumb.Render(strings.Join(crumbs, breadcrumbSeparator)) + "\n")
+	}
+
+	c := styles.SelectSelector.String()
+	options := t.level()
+	for i, option := range options {
+		text := option.Key
+		if len(option.children) > 0 {
+			text += strings.TrimRight(breadcrumbSeparator, " ")
+		}
+		if t.selected == i {
+			sb.WriteString(c + styles.SelectedOption.Render(text))
+		} else {
+			sb.WriteString(strings.Repeat(" ", lipgloss.Width(c)) + styles.Option.Render(text))
+		}
+		if i < len(options)-1 {
+			sb.WriteString("\n")
+		}
+	}
+	return fieldBase(styles, t.noPadding).Render(sb.String())
+}
+
+// Run runs the tree field.
+func (t *Tree[T]) Run() error {
+	if t.accessible {
+		return t.runAccessible()
+	}
+	return Run(t)
+}
+
+// runAccessible runs an accessible tree field, prompting for one level at a
+// time. Below the top level, 0 returns to the parent level.
+func (t *Tree[T]) runAccessible() error {
+	fmt.Println(accessibleBlock(t.theme.Blurred.Base, t.theme.Focused.Title.Render(t.title), t.width))
+	t.path, t.selected = nil, 0
+
+	for {
+		var sb strings.Builder
+		if crumbs := t.breadcrumb(); len(crumbs) > 0 {
+			sb.WriteString(strings.Join(crumbs, breadcrumbSeparator) + "\n")
+			sb.WriteString(t.strings.OptionFormat("0", t.strings.Up) + "\n")
+		}
+		options := t.level()
+		for i, option := range options {
+			text := option.Key
+			if len(option.children) > 0 {
+				text += strings.TrimRight(breadcrumbSeparator, " ")
+			}
+			sb.WriteString(t.strings.formatOption(i, text) + "\n")
+		}
+		fmt.Println(accessibleBlock(t.theme.Blurred.Base, sb.String(), t.width))
+
+		first := 1
+		if len(t.path) > 0 {
+			first = 0
+		}
+		choice, err := promptChoice(t.strings, t.strings.Choose, first, len(options))
+		if err != nil {
+			return err
+		}
+		if choice == 0 {
+			t.ascend()
+			continue
+		}
+		t.selected = choice - 1
+		if t.descend() {
+			continue
+		}
+
+		option := options[choice-1]
+		if err := t.validate(option.Value); err != nil {
+			fmt.Println(t.strings.reprompt(err))
+			continue
+		}
+		fmt.Println(t.theme.Focused.SelectedOption.Render(t.strings.Chose + option.Key + "\n"))
+		*t.value = option.Value
+		return nil
+	}
+}
+
+// WithTheme sets the theme of the tree field.
+func (t *Tree[T]) WithTheme(theme *Theme) Field {
+	t.theme = theme
+	return t
+}
+
+// WithKeyMap sets the keymap of the tree field.
+func (t *Tree[T]) WithKeyMap(k *KeyMap) Field {
+	t.keymap = &k.Tree
+	return t
+}
+
+// WithAccessible sets the accessible mode of the tree field.
+func (t *Tree[T]) WithAccessible(accessible bool) Field {
+	t.accessible = accessible
+	return t
+}
+
+// WithStrings sets the user-facing strings of the tree field.
+func (t *Tree[T]) WithStrings(strings *Strings) Field {
+	t.strings = strings
+	return t
+}
+
+// WithWidth sets the width of the tree field.
+func (t *Tree[T]) WithWidth(width int) Field {
+	t.width = width
+	return t
+}
+
+// WithoutPadding sets whether the tree field is rendered without the borders
+// and padding of its theme.
+func (t *Tree[T]) WithoutPadding(v bool) Field {
+	t.noPadding = v
+	return t
+}
+
+// WithDescriptionVisibility sets when the description of the tree field is
+// shown.
+func (t *Tree[T]) WithDescriptionVisibility(v DescriptionVisibility) Field {
+	t.descVisibility = v
+	return t
+}
+
+// WithDescriptionHeight limits the description of the tree field to the
+// given number of lines.
+func (t *Tree[T]) WithDescriptionHeight(height int) Field {
+	t.descScroll.height = height
+	return t
+}
+
+// scrollDescription scrolls the description of the tree field.
+func (t *Tree[T]) scrollDescription(delta int) {
+	t.descScroll.scroll(t.description, delta)
+}
+
+// WithUpdateHook sets the update hook of the tree field, see
+// Input.WithUpdateHook.
+func (t *Tree[T]) WithUpdateHook(hook func(tea.Msg) tea.Msg) Field {
+	t.updateHook = hook
+	return t
+}
+
+// withRecover sets whether the tree field recovers from panics in its
+// callbacks.
+func (t *Tree[T]) withRecover(v bool) {
+	t.failFast = !v
+}
+
+// withValidationMode sets when the tree field validates its value.
+func (t *Tree[T]) withValidationMode(mode ValidationMode) {
+	t.validationMode = mode
+}
+
+// GetKey returns the key of the field.
+func (t *Tree[T]) GetKey() string {
+	return t.key
+}
+
+// GetValue returns the value of the field.
+func (t *Tree[T]) GetValue() any {
+	return *t.value
+}
+
+// GetTitle returns the title of the field.
+func (t *Tree[T]) GetTitle() string {
+	return t.title
+}
+
+// DisplayValue returns the path to the leaf option matching the field's
+// value.
+func (t *Tree[T]) DisplayValue() string {
+	path := findLeaf(t.options, *t.value)
+	if path == nil {
+		return ""
+	}
+	var keys []string
+	options := t.options
+	for _, i := range path {
+		keys = append(keys, options[i].Key)
+		options = options[i].children
+	}
+	return strings.Join(keys, breadcrumbSeparator)
+}
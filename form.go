@@ -2,11 +2,19 @@ package huh
 
 import (
 	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"time"
 
+	"github.com/charmbracelet/bubbles/cursor"
 	"github.com/charmbracelet/bubbles/help"
 	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/paginator"
+	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
 )
 
 // FormState represents the current state of the form.
@@ -23,6 +31,92 @@ const (
 	StateAborted
 )
 
+// SubmitMsg is sent by the command Form.Update returns when the form is
+// completed, so a model embedding the form can react without checking its
+// State on every update.
+type SubmitMsg struct{}
+
+// AbortMsg is sent by the command Form.Update returns when the form is
+// aborted.
+type AbortMsg struct{}
+
+func submitted() tea.Msg {
+	return SubmitMsg{}
+}
+
+func aborted() tea.Msg {
+	return AbortMsg{}
+}
+
+// Layout is how a form is laid out around its fields.
+type Layout int
+
+const (
+	// LayoutDefault renders the fields with their borders and padding,
+	// followed by help.
+	LayoutDefault Layout = iota
+
+	// LayoutMinimal renders just the fields, without help or the borders and
+	// padding of the fields. Errors are still shown below the fields. This is
+	// useful when a form is a pane in a larger application.
+	LayoutMinimal
+)
+
+// ErrorPlacement is where the validation errors of fields are shown.
+type ErrorPlacement int
+
+const (
+	// ErrorPlacementBelow lists errors below the fields of a group.
+	ErrorPlacementBelow ErrorPlacement = iota
+
+	// ErrorPlacementRight shows the error of a field to its right, wrapped to
+	// the remaining width, which keeps row heights consistent in column
+	// layouts. A third of the width of each group is kept for the errors.
+	ErrorPlacementRight
+
+	// ErrorPlacementTooltip shows the error of a field in a box directly below
+	// it.
+	ErrorPlacementTooltip
+)
+
+// ValidationMode is when the validation of fields runs.
+type ValidationMode int
+
+const (
+	// ValidationOnBlur validates a field when it's left, which keeps the
+	// user on it until it's valid.
+	ValidationOnBlur ValidationMode = iota
+
+	// ValidationOnSubmit defers validation until the form is submitted, then
+	// validates every field and jumps to the first one with an error.
+	ValidationOnSubmit
+
+	// ValidationInline validates like ValidationOnBlur, and also validates
+	// input and text fields as they're typed in.
+	ValidationInline
+)
+
+// DescriptionVisibility is when the descriptions of fields are shown.
+type DescriptionVisibility int
+
+const (
+	// DescriptionAlways always shows descriptions.
+	DescriptionAlways DescriptionVisibility = iota
+
+	// DescriptionOnFocus only shows the description of the focused field,
+	// which declutters dense forms.
+	DescriptionOnFocus
+
+	// DescriptionNever never shows descriptions.
+	DescriptionNever
+)
+
+// show returns whether a description is shown given whether its field is
+// focused.
+func (v DescriptionVisibility) show(focused bool) bool {
+	return v == DescriptionAlways || v == DescriptionOnFocus && focused
+}
+
 // ErrUserAborted is the error returned when a user exits the form before submitting.
 var ErrUserAborted = errors.New("user aborted")
 
@@ -43,20 +137,93 @@ type Form struct {
 	submitCmd tea.Cmd
 	cancelCmd tea.Cmd
 
+	// State is the current state of the form. When a form is completed or
+	// aborted, Update also returns a command producing a SubmitMsg or an
+	// AbortMsg.
 	State FormState
 
 	// whether or not to use bubble tea rendering for accessibility
 	// purposes, if true, the form will render with basic prompting primitives
 	// to be more accessible to screen readers.
-	accessible bool
-
-	quitting bool
-	aborted  bool
+	accessible           bool
+	accessibleBell       bool
+	accessibleQuitPrompt bool
+
+	initialized bool
+	quitting    bool
+	aborted     bool
+
+	// whether answered fields and groups collapse to a single line
+	inlineHistory bool
+
+	// options of the groups, applied to those inserted later too, see
+	// applyOptions
+	showHelp              bool
+	showErrors            bool
+	groupedHelp           bool
+	compactHelp           bool
+	cursorMode            cursor.Mode
+	descriptionVisibility DescriptionVisibility
+	focusedBorder         bool
+	valueFooter           bool
+	errorPlacement        ErrorPlacement
+	layout                Layout
+
+	// whether a summary of the answers is left on screen once completed
+	keepCompleted bool
+
+	// the number of lines the form takes at least
+	minHeight int
+
+	// the padding around the form, overriding the theme's if set
+	padding []int
 
 	// options
-	width  int
-	theme  *Theme
-	keymap *KeyMap
+	width        int
+	widthPercent int
+	theme        *Theme
+	keymap       *KeyMap
+	strings      *Strings
+
+	// when the validation of fields runs
+	validationMode ValidationMode
+
+	// whether submitting lists the required fields that are still empty,
+	// and whether it was stopped by them
+	requiredSummary bool
+	summarizing     bool
+
+	// whether the titles of fields can be searched, and the search while
+	// it's open
+	fieldSearch  bool
+	searching    bool
+	searchInput  textinput.Model
+	searchCursor int
+
+	// events
+	eventHandler func(Event)
+	events       chan Event
+	eventsDone   chan struct{}
+	failFast     bool
+	autosave     func(map[string]any)
+
+	// values being edited, to find changes
+	initialValues map[string]any
+
+	// idle timeout
+	idleTimeout  time.Duration
+	idleAction   IdleAction
+	idleDeadline time.Time
+
+	// clipboard, the number of copies so far, which tells their
+	// confirmations apart, and whether the confirmation is shown
+	clipboard Clipboard
+	copies    int
+	copied    bool
+
+	// transcript of accessible runs
+	transcript      io.Writer
+	transcriptLines []string
 }
 
 // NewForm returns a form with the given groups and default themes and
@@ -69,23 +236,85 @@ func NewForm(groups ...*Group) *Form {
 	p.SetTotalPages(len(groups))
 
 	f := &Form{
-		groups:    groups,
-		paginator: p,
-		theme:     ThemeCharm(),
-		keymap:    NewDefaultKeyMap(),
-		width:     0,
-		results:   make(map[string]any),
-	}
-
-	// NB: If dynamic forms come into play this will need to be applied when
-	// groups and fields are added.
-	f.WithTheme(f.theme)
-	f.WithKeyMap(f.keymap)
-	f.WithWidth(f.width)
+		groups:     groups,
+		paginator:  p,
+		theme:      ThemeCharm(),
+		keymap:     NewDefaultKeyMap(),
+		strings:    DefaultStrings(),
+		width:      0,
+		results:    make(map[string]any),
+		clipboard:  systemClipboard{},
+		showHelp:   true,
+		showErrors: true,
+	}
+
+	for _, group := range groups {
+		f.applyOptions(group)
+	}
 
 	return f
 }
 
+// applyOptions applies the options of the form to a group, whether it's one
+// the form was made with or one inserted later. Options the form leaves at
+// their defaults are left to the group.
+func (f *Form) applyOptions(g *Group) {
+	if f.layout != LayoutDefault {
+		g.WithLayout(f.layout)
+	}
+	g.WithTheme(f.theme)
+	g.WithKeyMap(f.keymap)
+	g.WithStrings(f.strings)
+	if f.failFast {
+		g.WithRecover(false)
+	}
+	if !f.showHelp {
+		g.WithShowHelp(false)
+	}
+	if !f.showErrors {
+		g.WithShowErrors(false)
+	}
+	if f.groupedHelp {
+		g.WithGroupedHelp(true)
+	}
+	if f.compactHelp {
+		g.WithCompactHelp(true)
+	}
+	if f.cursorMode != cursor.CursorBlink {
+		g.WithCursorMode(f.cursorMode)
+	}
+	if f.descriptionVisibility != DescriptionAlways {
+		g.WithDescriptionVisibility(f.descriptionVisibility)
+	}
+	if f.focusedBorder {
+		g.WithFocusedBorder(true)
+	}
+	if f.valueFooter {
+		g.WithValueFooter(true)
+	}
+	if f.validationMode != ValidationOnBlur {
+		g.WithValidationMode(f.validationMode)
+	}
+	if f.errorPlacement != ErrorPlacementBelow {
+		g.WithErrorPlacement(f.errorPlacement)
+	}
+	if f.inlineHistory {
+		g.WithInlineHistory(true)
+	}
+	g.fieldSearch = f.fieldSearch
+	if f.width > 0 {
+		g.WithWidth(f.innerWidth(f.width))
+	}
+}
+
+// withDefaults sets the default theme and keymap on a new field, so that it
+// renders and runs on its own. A form replaces them with its own.
+func withDefaults[F Field](field F) F {
+	field.WithTheme(ThemeCharm())
+	field.WithKeyMap(NewDefaultKeyMap())
+	return field
+}
+
 // Field is a primitive of a form.
 //
 // A field represents a single input control on a form such as a text input,
@@ -130,6 +359,85 @@ type Field interface {
 	GetValue() any
 }
 
+// titler is implemented by fields with a title.
+type titler interface {
+	GetTitle() string
+}
+
+// fieldTitle returns the title of a field, if it has one.
+func fieldTitle(field Field) string {
+	if t, ok := field.(titler); ok {
+		return t.GetTitle()
+	}
+	return ""
+}
+
+// displayValuer is implemented by fields that display their value other than
+// as it is formatted by default, such as by the key of the chosen option.
+type displayValuer interface {
+	DisplayValue() string
+}
+
+// displayValue returns the value of a field as it is displayed to the user.
+func displayValue(field Field) string {
+	if d, ok := field.(displayValuer); ok {
+		return d.DisplayValue()
+	}
+	if value := field.GetValue(); value != nil {
+		return fmt.Sprint(value)
+	}
+	return ""
+}
+
+// setError is an error set on a field with SetError, along with the value of
+// the field at the time so that it can be cleared once the value changes.
+type setError struct {
+	err   error
+	value any
+}
+
+// get returns the set error if the value hasn't changed, clearing it
+// otherwise.
+func (e *setError) get(value any) error {
+	if e.err != nil && !reflect.DeepEqual(value, e.value) {
+		e.err = nil
+	}
+	return e.err
+}
+
+// keep restores the set error to err, if there is no other error, as long as
+// the value is unchanged. Fields clear their errors on each key press, but
+// keep set errors until the key changes their value.
+func (e *setError) keep(err *error, value any) {
+	if *err == nil {
+		*err = e.get(value)
+	}
+}
+
+// runUpdateHook runs an update hook, if any, on a message.
+func runUpdateHook(hook func(tea.Msg) tea.Msg, msg tea.Msg) tea.Msg {
+	if hook == nil {
+		return msg
+	}
+	return hook(msg)
+}
+
+// submitFormMsg is a message to submit the form, such as from a submit button.
+type submitFormMsg struct{}
+
+// cancelFormMsg is a message to abort the form, such as from a cancel button.
+type cancelFormMsg struct{}
+
+// submitForm is the command to submit the form.
+func submitForm() tea.Msg {
+	return submitFormMsg{}
+}
+
+// cancelForm is the command to abort the form.
+func cancelForm() tea.Msg {
+	return cancelFormMsg{}
+}
+
 // nextGroupMsg is a message to move to the next group.
 type nextGroupMsg struct{}
 
@@ -157,33 +465,172 @@ func (f *Form) WithAccessible(accessible bool) *Form {
 	return f
 }
 
+// WithAccessibleBell sets whether accessible errors ring the terminal bell,
+// which many screen readers announce, before they are printed.
+func (f *Form) WithAccessibleBell(v bool) *Form {
+	f.accessibleBell = v
+	return f
+}
+
+// WithAccessibleAbortConfirm sets whether answering an accessible form with
+// the Quit answer, or interrupting it with ctrl+c, asks for confirmation
+// before aborting. Declining asks the question again.
+func (f *Form) WithAccessibleAbortConfirm(v bool) *Form {
+	f.accessibleQuitPrompt = v
+	return f
+}
+
 // WithShowHelp sets whether or not the form should show help.
 //
 // This allows the form groups and field to show what keybindings are available
 // to the user.
 func (f *Form) WithShowHelp(v bool) *Form {
+	f.showHelp = v
 	for _, group := range f.groups {
 		group.WithShowHelp(v)
 	}
 	return f
 }
 
+// WithGroupedHelp sets whether the help of the form is split into sections
+// for navigation, editing and the form, see Group.WithGroupedHelp.
+func (f *Form) WithGroupedHelp(v bool) *Form {
+	f.groupedHelp = v
+	for _, group := range f.groups {
+		group.WithGroupedHelp(v)
+	}
+	return f
+}
+
+// WithCompactHelp sets whether the help of the form is a single line legend,
+// see Group.WithCompactHelp.
+func (f *Form) WithCompactHelp(v bool) *Form {
+	f.compactHelp = v
+	for _, group := range f.groups {
+		group.WithCompactHelp(v)
+	}
+	return f
+}
+
 // WithShowErrors sets whether or not the form should show help.
 //
 // This allows the form groups and field to show what keybindings are available
 // to the user.
 func (f *Form) WithShowErrors(v bool) *Form {
+	f.showErrors = v
 	for _, group := range f.groups {
 		group.WithShowErrors(v)
 	}
 	return f
 }
 
+// WithInlineHistory sets whether answered fields should collapse to a single
+// "Title: value" line, leaving only the focused field expanded.
+//
+// This renders the form like a transcript of the previous questions and
+// answers. Navigating back expands the field again.
+func (f *Form) WithInlineHistory(v bool) *Form {
+	f.inlineHistory = v
+	for _, group := range f.groups {
+		group.WithInlineHistory(v)
+	}
+	return f
+}
+
+// WithKeepCompleted sets whether a summary of the answers, one "Title: value"
+// line per field, is left on screen once the form is completed. By default the
+// form is cleared. Aborted forms are always cleared.
+//
+// When the form runs in the alternate screen, such as within a program using
+// tea.WithAltScreen, nothing is left once the program exits.
+func (f *Form) WithKeepCompleted(v bool) *Form {
+	f.keepCompleted = v
+	return f
+}
+
+// WithMinHeight sets the number of lines the form takes at least. The form
+// is padded at the bottom to that height so that it doesn't change height, and
+// the content below it doesn't jump, as errors come and go.
+func (f *Form) WithMinHeight(height int) *Form {
+	f.minHeight = max(height, 0)
+	return f
+}
+
+// WithCursorMode sets the default cursor mode of the text fields in a form.
+//
+// Fields with their own cursor mode keep it.
+func (f *Form) WithCursorMode(mode cursor.Mode) *Form {
+	f.cursorMode = mode
+	for _, group := range f.groups {
+		group.WithCursorMode(mode)
+	}
+	return f
+}
+
+// WithDescriptionVisibility sets when the descriptions of the fields in a form
+// are shown.
+func (f *Form) WithDescriptionVisibility(v DescriptionVisibility) *Form {
+	f.descriptionVisibility = v
+	for _, group := range f.groups {
+		group.WithDescriptionVisibility(v)
+	}
+	return f
+}
+
+// WithFocusedBorder sets whether the focused field of a form is drawn in a
+// border, see Group.WithFocusedBorder.
+func (f *Form) WithFocusedBorder(v bool) *Form {
+	f.focusedBorder = v
+	for _, group := range f.groups {
+		group.WithFocusedBorder(v)
+	}
+	return f
+}
+
+// WithValueFooter sets whether the committed value of the focused field of a
+// form is shown below it, see Group.WithValueFooter.
+func (f *Form) WithValueFooter(v bool) *Form {
+	f.valueFooter = v
+	for _, group := range f.groups {
+		group.WithValueFooter(v)
+	}
+	return f
+}
+
+// WithValidationMode sets when the validation of a form's fields runs. The
+// default is ValidationOnBlur.
+func (f *Form) WithValidationMode(mode ValidationMode) *Form {
+	f.validationMode = mode
+	for _, group := range f.groups {
+		group.WithValidationMode(mode)
+	}
+	return f
+}
+
+// WithErrorPlacement sets where the validation errors of a form's fields are
+// shown. The default is ErrorPlacementBelow.
+func (f *Form) WithErrorPlacement(placement ErrorPlacement) *Form {
+	f.errorPlacement = placement
+	for _, group := range f.groups {
+		group.WithErrorPlacement(placement)
+	}
+	return f
+}
+
+// WithLayout sets the layout of a form.
+func (f *Form) WithLayout(layout Layout) *Form {
+	f.layout = layout
+	for _, group := range f.groups {
+		group.WithLayout(layout)
+	}
+	return f
+}
+
 // WithTheme sets the theme on a form.
 //
 // This allows all groups and fields to be themed consistently, however themes
 // can be applied to each group and field individually for more granular
-// control.
+// control. It can also be called while the form is running, see SetTheme.
 func (f *Form) WithTheme(theme *Theme) *Form {
 	if theme == nil {
 		return f
@@ -192,9 +639,18 @@ func (f *Form) WithTheme(theme *Theme) *Form {
 	for _, group := range f.groups {
 		group.WithTheme(theme)
 	}
+	// The padding of the theme's Form style takes from the groups' width.
+	f.WithWidth(f.width)
 	return f
 }
 
+// SetTheme changes the theme of a form that is already running, re-theming
+// all groups and fields. The form is rendered with the new theme on its next
+// view, such as after the update in which the theme is set.
+func (f *Form) SetTheme(theme *Theme) {
+	f.WithTheme(theme)
+}
+
 // WithKeyMap sets the keymap on a form.
 //
 // This allows customization of the form key bindings.
@@ -209,6 +665,21 @@ func (f *Form) WithKeyMap(keymap *KeyMap) *Form {
 	return f
 }
 
+// WithStrings sets the user-facing strings of a form.
+//
+// This allows the labels and accessible prompts of all groups and fields to be
+// translated.
+func (f *Form) WithStrings(strings *Strings) *Form {
+	if strings == nil {
+		return f
+	}
+	f.strings = strings
+	for _, group := range f.groups {
+		group.WithStrings(strings)
+	}
+	return f
+}
+
 // WithWidth sets the width of a form.
 //
 // This allows all groups and fields to be sized consistently, however width
@@ -220,16 +691,35 @@ func (f *Form) WithWidth(width int) *Form {
 	}
 	f.width = width
 	for _, group := range f.groups {
-		group.WithWidth(width)
+		group.WithWidth(f.innerWidth(width))
 	}
 	return f
 }
 
+// WithWidthPercent sets the form to take a percentage of the terminal width,
+// recomputed whenever the terminal is resized.
+//
+// A fixed width set with WithWidth takes precedence over the percentage. The
+// resulting width is at least 20 cells, unless the terminal is narrower.
+func (f *Form) WithWidthPercent(percent int) *Form {
+	f.widthPercent = clamp(percent, 0, 100)
+	return f
+}
+
 // Errors returns the current groups' errors.
 func (f *Form) Errors() []error {
 	return f.groups[f.paginator.Page].Errors()
 }
 
+// Warnings returns the warnings of all groups in the form.
+func (f *Form) Warnings() []string {
+	var warnings []string
+	for _, group := range f.groups {
+		warnings = append(warnings, group.Warnings()...)
+	}
+	return warnings
+}
+
 // Help returns the current groups' help.
 func (f *Form) Help() help.Model {
 	return f.groups[f.paginator.Page].help
@@ -246,6 +736,47 @@ func (f *Form) Get(key string) any {
 	return f.results[key]
 }
 
+// Values returns the values of the fields with keys, by key. Fields in hidden
+// groups are left out and secrets are redacted, use Get or the bound values
+// for the real values of secrets.
+func (f *Form) Values() map[string]any {
+	return f.valuesThrough(len(f.groups)-1, publicValue)
+}
+
+// valuesThrough returns the values of the fields with keys in the groups up to
+// the given one, as returned by value, skipping hidden groups.
+func (f *Form) valuesThrough(last int, value func(Field) any) map[string]any {
+	values := make(map[string]any)
+	for _, group := range f.groups[:last+1] {
+		if group.hide != nil && group.hide() {
+			continue
+		}
+		for _, field := range group.fields {
+			if key := field.GetKey(); key != "" {
+				values[key] = value(field)
+			}
+		}
+	}
+	return values
+}
+
+// Redacted replaces the values of secret fields in the output of a form, such
+// as its values, events, summaries and scripts.
+const Redacted = "***"
+
+// secreter is implemented by fields that can hold secrets.
+type secreter interface {
+	isSecret() bool
+}
+
+// publicValue returns the value of a field, or Redacted if it is a secret.
+func publicValue(field Field) any {
+	if s, ok := field.(secreter); ok && s.isSecret() {
+		return Redacted
+	}
+	return field.GetValue()
+}
+
 // GetString returns a result as a string from the form.
 func (f *Form) GetString(key string) string {
 	v, ok := f.results[key].(string)
@@ -297,20 +828,95 @@ func (f *Form) PrevField() tea.Cmd {
 	return cmd
 }
 
+// InsertGroup inserts a group at the given position, such as to add steps to
+// a wizard based on the answers so far. The group is set up with the form's
+// theme, keymap, strings, width and the other options set on the form, and
+// once the form has started the focus stays on the current field, even if
+// the group is inserted before it.
+//
+// It can be called while the form is running, such as from a validation
+// function, but not from other goroutines. The returned command initializes
+// the group and should be returned from the update.
+func (f *Form) InsertGroup(index int, group *Group) tea.Cmd {
+	index = clamp(index, 0, len(f.groups))
+
+	f.applyOptions(group)
+	if f.width <= 0 && len(f.groups) > 0 && f.groups[f.paginator.Page].width > 0 {
+		group.WithWidth(f.groups[f.paginator.Page].width)
+	}
+
+	f.groups = append(f.groups[:index], append([]*Group{group}, f.groups[index:]...)...)
+	f.paginator.SetTotalPages(len(f.groups))
+	if f.initialized && index <= f.paginator.Page && len(f.groups) > 1 {
+		f.paginator.Page++
+	}
+	return group.Init()
+}
+
+// RemoveGroup removes the group at the given position, along with the results
+// of its fields. Removing the current group moves the form on to the next
+// group, or back to the previous group if it was the last one. The last
+// remaining group can't be removed.
+//
+// Like InsertGroup, it can be called while the form is running, and the
+// returned command should be returned from the update.
+func (f *Form) RemoveGroup(index int) tea.Cmd {
+	if index < 0 || index >= len(f.groups) || len(f.groups) <= 1 {
+		return nil
+	}
+
+	group := f.groups[index]
+	for _, field := range group.fields {
+		delete(f.results, field.GetKey())
+	}
+	f.groups = append(f.groups[:index], f.groups[index+1:]...)
+	f.paginator.SetTotalPages(len(f.groups))
+
+	page := f.paginator.Page
+	switch {
+	case index < page:
+		f.paginator.Page--
+	case index == page:
+		group.fields[group.paginator.Page].Blur()
+		f.paginator.Page = min(page, len(f.groups)-1)
+		if f.isGroupHidden() {
+			if page < len(f.groups) {
+				return nextGroup
+			}
+			return prevGroup
+		}
+	}
+	return nil
+}
+
 // Init initializes the form.
 func (f *Form) Init() tea.Cmd {
-	cmds := make([]tea.Cmd, len(f.groups))
-	for i, group := range f.groups {
-		cmds[i] = group.Init()
-	}
+	cmds := []tea.Cmd{f.initGroups()}
 
 	if f.isGroupHidden() {
 		cmds = append(cmds, nextGroup)
+	} else if s := f.snapshot(); s.field != nil {
+		f.emit(EventFocus, s.field.GetKey(), nil, nil)
+	}
+
+	if f.idleTimeout > 0 {
+		f.resetIdle(time.Now())
+		cmds = append(cmds, idleTick())
 	}
 
 	return tea.Batch(cmds...)
 }
 
+// initGroups initializes the groups, focusing their first fields.
+func (f *Form) initGroups() tea.Cmd {
+	f.initialized = true
+	cmds := make([]tea.Cmd, len(f.groups))
+	for i, group := range f.groups {
+		cmds[i] = group.Init()
+	}
+	return tea.Batch(cmds...)
+}
+
 // Update updates the form.
 func (f *Form) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	// If the form is aborted or completed there's no need to update it.
@@ -318,40 +924,119 @@ func (f *Form) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return f, nil
 	}
 
+	if f.eventHandler != nil {
+		defer f.emitChanges(f.snapshot())
+	}
+
 	page := f.paginator.Page
 	group := f.groups[page]
 
 	switch msg := msg.(type) {
+	case broadcastMsg:
+		cmds := make([]tea.Cmd, len(f.groups))
+		for i, group := range f.groups {
+			_, cmds[i] = group.Update(msg)
+		}
+		return f, tea.Batch(cmds...)
 	case tea.WindowSizeMsg:
 		if f.width > 0 {
 			break
 		}
+		width := msg.Width
+		if f.widthPercent > 0 {
+			width = percentWidth(width, f.widthPercent)
+		}
 		for _, group := range f.groups {
-			group.WithWidth(msg.Width)
+			group.WithWidth(f.innerWidth(width))
 		}
+	case idleTickMsg:
+		if f.busy() {
+			f.resetIdle(time.Time(msg))
+			return f, idleTick()
+		}
+		if !time.Time(msg).Before(f.idleDeadline) {
+			return f, f.idle()
+		}
+		return f, idleTick()
+	case clearCopiedMsg:
+		if int(msg) == f.copies {
+			f.copied = false
+		}
+		return f, nil
 	case tea.KeyMsg:
+		f.resetIdle(time.Now())
+		f.copied = false
+		if f.searching {
+			return f, f.updateSearch(msg)
+		}
 		switch {
+		case typedText(msg) && group.enteringText():
+			// Typed text goes to the field, see KeyMap.Conflicts.
+		case f.fieldSearch && key.Matches(msg, f.keymap.Search) && !group.fieldBinds(msg.String()):
+			return f, f.openSearch()
 		case key.Matches(msg, f.keymap.Quit):
-			f.aborted = true
-			f.quitting = true
-			f.State = StateAborted
-			return f, f.cancelCmd
+			return f, f.abort()
+		case key.Matches(msg, f.keymap.Copy):
+			return f, f.copyValue()
+		case key.Matches(msg, f.keymap.Submit):
+			return f, f.submitAll()
+		case key.Matches(msg, f.keymap.DescriptionUp):
+			f.scrollDescription(-1)
+			return f, nil
+		case key.Matches(msg, f.keymap.DescriptionDown):
+			f.scrollDescription(1)
+			return f, nil
+		case key.Matches(msg, f.keymap.Help) && !group.enteringText():
+			for _, group := range f.groups {
+				group.help.ShowAll = !group.help.ShowAll
+			}
+			return f, nil
 		}
 
 	case nextFieldMsg:
-		// Form is progressing to the next field, let's save the value of the current field.
+		// Form is progressing to the next field, let's save the value of the
+		// current field once the group has blurred it, which is when some
+		// fields store their value.
 		field := group.fields[group.paginator.Page]
-		f.results[field.GetKey()] = field.GetValue()
+		defer func() { f.results[field.GetKey()] = field.GetValue() }()
+
+	case submitFormMsg:
+		if group.validationMode == ValidationOnSubmit {
+			return f, f.submitAll()
+		}
+		if cmd, ok := f.focusMissing(); ok {
+			return f, cmd
+		}
+		if group.runValidation() != nil || len(group.Errors()) > 0 {
+			return f, nil
+		}
+		if f.autosave != nil {
+			f.autosave(f.valuesThrough(page, publicValue))
+		}
+		return f, f.complete()
+
+	case cancelFormMsg:
+		return f, f.abort()
 
 	case nextGroupMsg:
-		if len(group.Errors()) > 0 {
+		deferred := group.validationMode == ValidationOnSubmit
+		if f.paginator.OnLastPage() && !deferred {
+			if cmd, ok := f.focusMissing(); ok {
+				return f, cmd
+			}
+		}
+		if len(group.Errors()) > 0 && !deferred {
 			return f, nil
 		}
+		if f.autosave != nil {
+			f.autosave(f.valuesThrough(page, publicValue))
+		}
 
 		if f.paginator.OnLastPage() {
-			f.quitting = true
-			f.State = StateCompleted
-			return f, f.submitCmd
+			if deferred {
+				return f, f.submitAll()
+			}
+			return f, f.complete()
 		}
 		f.paginator.NextPage()
 
@@ -360,7 +1045,7 @@ func (f *Form) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 
 	case prevGroupMsg:
-		if len(group.Errors()) > 0 {
+		if len(group.Errors()) > 0 && group.validationMode != ValidationOnSubmit {
 			return f, nil
 		}
 		f.paginator.PrevPage()
@@ -370,12 +1055,69 @@ func (f *Form) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 	}
 
-	m, cmd := group.Update(msg)
-	f.groups[page] = m.(*Group)
+	// Groups update in place, and may be inserted or removed during the
+	// update, so the group isn't stored back by its position.
+	_, cmd := group.Update(msg)
 
 	return f, cmd
 }
 
+// complete completes the form.
+func (f *Form) complete() tea.Cmd {
+	f.quitting = true
+	f.State = StateCompleted
+	return tea.Batch(f.submitCmd, submitted)
+}
+
+// submitAll validates every field and group that isn't hidden and completes
+// the form, or focuses the first field that is required but empty, see
+// Form.WithRequiredSummary, or else the first field with an error.
+func (f *Form) submitAll() tea.Cmd {
+	group := f.groups[f.paginator.Page]
+	group.fields[group.paginator.Page].Blur()
+	for _, group := range f.groups {
+		if group.hide != nil && group.hide() {
+			continue
+		}
+		group.validateFields()
+	}
+	if cmd, ok := f.focusMissing(); ok {
+		return cmd
+	}
+
+	for i, group := range f.groups {
+		if group.hide != nil && group.hide() {
+			continue
+		}
+		if current := group.firstError(); current >= 0 {
+			f.paginator.Page = i
+			group.paginator.Page = current
+			return group.fields[current].Focus()
+		}
+	}
+
+	for _, group := range f.groups {
+		if group.hide != nil && group.hide() {
+			continue
+		}
+		for _, field := range group.fields {
+			f.results[field.GetKey()] = field.GetValue()
+		}
+	}
+	if f.autosave != nil {
+		f.autosave(f.Values())
+	}
+	return f.complete()
+}
+
+// abort aborts the form.
+func (f *Form) abort() tea.Cmd {
+	f.aborted = true
+	f.quitting = true
+	f.State = StateAborted
+	return tea.Batch(f.cancelCmd, aborted)
+}
+
 func (f *Form) isGroupHidden() bool {
 	hide := f.groups[f.paginator.Page].hide
 	if hide == nil {
@@ -384,13 +1126,103 @@ func (f *Form) isGroupHidden() bool {
 	return hide()
 }
 
-// View renders the form.
+// Render renders a form that may not be running, such as for golden tests.
+//
+// A form that wasn't initialized yet is initialized first, as long as it was
+// built with NewForm, which sets its default theme and keymap. It then shows
+// its first visible group as the form starts, with the first field focused.
+// Commands started by the fields, such as to load options, don't run until
+// the form does. Otherwise, Render is the same as View.
+func (f *Form) Render() string {
+	if !f.initialized {
+		f.initGroups()
+		for f.isGroupHidden() && !f.paginator.OnLastPage() {
+			f.paginator.NextPage()
+		}
+	}
+	return f.View()
+}
+
+// View renders the form. See Render to render a form before it runs.
 func (f *Form) View() string {
 	if f.quitting {
+		if f.keepCompleted && f.State == StateCompleted {
+			return f.frame(f.completedView())
+		}
 		return ""
 	}
 
-	return f.groups[f.paginator.Page].View()
+	var s strings.Builder
+	for i := 0; f.inlineHistory && i < f.paginator.Page; i++ {
+		group := f.groups[i]
+		if group.hide != nil && group.hide() {
+			continue
+		}
+		s.WriteString(group.historyView())
+	}
+	if f.searching {
+		s.WriteString(f.searchView())
+	} else {
+		s.WriteString(f.groups[f.paginator.Page].View())
+	}
+	s.WriteString(f.requiredSummaryView())
+	s.WriteString(f.copiedView())
+	s.WriteString(f.idleView(time.Now()))
+
+	view := s.String()
+	minHeight := f.minHeight - f.frameStyle().GetVerticalFrameSize()
+	if height := lipgloss.Height(view); height < minHeight {
+		view += strings.Repeat("\n", minHeight-height)
+	}
+	return f.frame(view)
+}
+
+// WithPadding sets the padding around the whole form, in place of the
+// padding of the theme's Form style. The width and minimum height of the
+// form include it.
+func (f *Form) WithPadding(top, right, bottom, left int) *Form {
+	f.padding = []int{top, right, bottom, left}
+	f.WithWidth(f.width)
+	return f
+}
+
+// frameStyle returns the style framing the whole form, which is the theme's
+// Form style with the form's padding, if set.
+func (f *Form) frameStyle() lipgloss.Style {
+	style := f.theme.Form.Copy()
+	if f.padding != nil {
+		style = style.Padding(f.padding...)
+	}
+	return style
+}
+
+// frame renders a view of the form in its frame, leaving it as it is when
+// the frame has no spacing. Escape sequences, such as links, are written
+// last, see deferEscape.
+func (f *Form) frame(view string) string {
+	style := f.frameStyle()
+	if style.GetHorizontalFrameSize() == 0 && style.GetVerticalFrameSize() == 0 {
+		return writeEscapes(view)
+	}
+	return writeEscapes(style.Render(view))
+}
+
+// innerWidth returns the width left to the groups of a form of the given
+// width, inside its frame.
+func (f *Form) innerWidth(width int) int {
+	return max(width-f.frameStyle().GetHorizontalFrameSize(), 1)
+}
+
+// completedView renders the answers of a completed form.
+func (f *Form) completedView() string {
+	var s strings.Builder
+	for _, group := range f.groups {
+		if group.hide != nil && group.hide() {
+			continue
+		}
+		s.WriteString(group.historyView())
+	}
+	return s.String()
 }
 
 // Run runs the form.
@@ -409,24 +1241,268 @@ func (f *Form) Run() error {
 	return f.run()
 }
 
+// RunGroup runs the group at index on its own, completing once it's done,
+// such as for a tab of a settings screen. The group's fields are validated
+// as usual and write to their bound values, so that values carry over to
+// later runs of other groups and of the whole form. It runs in accessible
+// mode if the form does.
+func (f *Form) RunGroup(index int) error {
+	if index < 0 || index >= len(f.groups) {
+		return fmt.Errorf("huh: no group at index %d", index)
+	}
+
+	groups, page, total := f.groups, f.paginator.Page, f.paginator.TotalPages
+	f.groups = groups[index : index+1 : index+1]
+	f.paginator.SetTotalPages(1)
+	f.paginator.Page = 0
+	defer func() {
+		f.groups = groups
+		f.paginator.SetTotalPages(total)
+		f.paginator.Page = page
+	}()
+
+	f.State = StateNormal
+	f.initialized = false
+	f.quitting = false
+	f.aborted = false
+	return f.Run()
+}
+
+// programOptions are the options of the programs forms run in, such as the
+// input tests type into.
+var programOptions []tea.ProgramOption
+
 // run runs the form in normal mode.
 func (f *Form) run() error {
-	m, err := tea.NewProgram(f).Run()
+	m, err := tea.NewProgram(f, programOptions...).Run()
 	if m.(*Form).aborted {
 		err = ErrUserAborted
 	}
 	return err
 }
 
+// quitConfirmed returns whether quitting an accessible form with the given
+// error is confirmed, which it always is unless the form asks for
+// confirmation. Quitting after an interrupt was already confirmed.
+func (f *Form) quitConfirmed(s *Strings, err error) bool {
+	if !f.accessibleQuitPrompt || errors.Is(err, errQuitConfirmed) {
+		return true
+	}
+	quit, err := promptBool(s, s.ConfirmQuit)
+	switch {
+	case errors.Is(err, errQuit):
+		quit = true
+	case err != nil:
+		quit = false
+	}
+	if !quit {
+		fmt.Println()
+	}
+	return quit
+}
+
 // runAccessible runs the form in accessible mode.
+//
+// The questions of the form are asked one after the other, numbered, and
+// answering with the Back answer returns to the previous question. Once all
+// questions are answered the answers are summarized before submitting.
 func (f *Form) runAccessible() error {
+	s := *f.strings
+	s.allowBack = true
+	s.allowGoTo = f.fieldSearch
+	s.bell = f.accessibleBell
+	if f.accessibleQuitPrompt {
+		defer f.trapInterrupts(&s)()
+	}
 	for _, group := range f.groups {
-		for _, field := range group.fields {
-			field.Init()
-			field.Focus()
-			_ = field.WithAccessible(true).Run()
+		group.WithStrings(&s)
+	}
+	defer func() {
+		for _, group := range f.groups {
+			group.WithStrings(f.strings)
+		}
+	}()
+
+	for pos := 0; ; {
+		fields := f.visibleFields()
+		if pos >= len(fields) {
+			submit, err := f.accessibleSummary(&s, fields)
+			if errors.Is(err, errBack) {
+				fmt.Println()
+				pos = previousQuestion(fields, len(fields), &s)
+				continue
+			}
+			var goTo goToError
+			if errors.As(err, &goTo) {
+				fmt.Println()
+				pos = goToQuestion(fields, len(fields), goTo.question, &s)
+				continue
+			}
+			if errors.Is(err, errQuit) && !f.quitConfirmed(&s, err) {
+				continue
+			}
+			if !submit {
+				f.aborted = true
+				f.State = StateAborted
+				return ErrUserAborted
+			}
+			f.State = StateCompleted
+			f.flushTranscript()
+			return nil
 		}
+
+		field := fields[pos]
+		if n, total := questionNumber(fields, field); n > 0 {
+			fmt.Printf(s.Question+"\n", n, total)
+		}
+
+		s.question = fieldTitle(field)
+		field.Init()
+		field.Focus()
+		err := field.WithAccessible(true).Run()
+		if errors.Is(err, errBack) {
+			fmt.Println()
+			pos = previousQuestion(fields, pos, &s)
+			continue
+		}
+		var goTo goToError
+		if errors.As(err, &goTo) {
+			fmt.Println()
+			pos = goToQuestion(fields, pos, goTo.question, &s)
+			continue
+		}
+		if errors.Is(err, errQuit) {
+			if !f.quitConfirmed(&s, err) {
+				continue
+			}
+			f.abort()
+			return ErrUserAborted
+		}
+		if _, ok := field.(*Button); ok {
+			// Buttons are the final choice, submitting or aborting the form
+			// without a summary.
+			if err != nil {
+				f.abort()
+				return err
+			}
+			f.complete()
+			f.flushTranscript()
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		f.results[field.GetKey()] = field.GetValue()
+		f.record(field)
+
+		// Validate the group once its last field is answered, going back to
+		// its first field if the group is invalid.
+		if group := f.groupOf(field); group != nil && group.fields[group.tabOrder()[len(group.fields)-1]] == field {
+			restore := group.submitting()
+			err := group.runValidation()
+			restore()
+			if err != nil {
+				fmt.Println(f.theme.Focused.ErrorMessage.Render(s.errorText(err)))
+				fmt.Println()
+				pos = indexOf(fields, group.fields[group.tabOrder()[0]])
+				continue
+			}
+		}
+
+		// Answering may have hidden or shown groups, find the field again.
+		fields = f.visibleFields()
+		for i := range fields {
+			if fields[i] == field {
+				pos = i
+				break
+			}
+		}
+		pos++
+	}
+}
+
+// accessibleSummary prints the answers of an accessible form and asks
+// whether to submit it.
+func (f *Form) accessibleSummary(s *Strings, fields []Field) (bool, error) {
+	var sb strings.Builder
+	sb.WriteString(f.theme.Focused.Title.Render(s.Summary) + "\n")
+	for _, field := range fields {
+		if !isQuestion(field) {
+			continue
+		}
+		sb.WriteString(fieldTitle(field) + ": " + displayValue(field) + "\n")
 	}
+	fmt.Println(accessibleBlock(f.theme.Blurred.Base, sb.String(), f.width))
+	return promptBool(s, s.Submit)
+}
 
+// visibleFields returns the fields of the groups that aren't hidden.
+func (f *Form) visibleFields() []Field {
+	var fields []Field
+	for _, group := range f.groups {
+		if group.hide != nil && group.hide() {
+			continue
+		}
+		for _, i := range group.tabOrder() {
+			fields = append(fields, group.fields[i])
+		}
+	}
+	return fields
+}
+
+// groupOf returns the group of a field.
+func (f *Form) groupOf(field Field) *Group {
+	for _, group := range f.groups {
+		if indexOf(group.fields, field) >= 0 {
+			return group
+		}
+	}
 	return nil
 }
+
+// indexOf returns the position of a field among fields, or -1.
+func indexOf(fields []Field, field Field) int {
+	for i := range fields {
+		if fields[i] == field {
+			return i
+		}
+	}
+	return -1
+}
+
+// isQuestion returns whether a field is a question, which are the fields that
+// aren't notes or buttons.
+func isQuestion(field Field) bool {
+	switch field.(type) {
+	case *Note, *Button:
+		return false
+	}
+	return true
+}
+
+// questionNumber returns the position of a field among the questions and the
+// number of questions. The position is zero for other fields.
+func questionNumber(fields []Field, field Field) (n, total int) {
+	for _, f := range fields {
+		if !isQuestion(f) {
+			continue
+		}
+		total++
+		if f == field {
+			n = total
+		}
+	}
+	return n, total
+}
+
+// previousQuestion returns the position of the question before pos, skipping
+// other fields. It stays at pos if there is no previous question.
+func previousQuestion(fields []Field, pos int, s *Strings) int {
+	for i := pos - 1; i >= 0; i-- {
+		if isQuestion(fields[i]) {
+			return i
+		}
+	}
+	fmt.Println(s.FirstQuestion)
+	return pos
+}
@@ -0,0 +1,41 @@
+package huh
+
+import "github.com/rivo/uniseg"
+
+// graphemeStarts returns the rune offsets at which the grapheme clusters of s
+// start, followed by the number of runes in s.
+func graphemeStarts(s string) []int {
+	starts := []int{0}
+	g := uniseg.NewGraphemes(s)
+	pos := 0
+	for g.Next() {
+		pos += len(g.Runes())
+		starts = append(starts, pos)
+	}
+	return starts
+}
+
+// graphemeBefore returns the rune offset of the start of the grapheme cluster
+// before pos.
+func graphemeBefore(s string, pos int) int {
+	before := 0
+	for _, start := range graphemeStarts(s) {
+		if start >= pos {
+			break
+		}
+		before = start
+	}
+	return before
+}
+
+// graphemeAfter returns the rune offset of the end of the grapheme cluster
+// after pos.
+func graphemeAfter(s string, pos int) int {
+	starts := graphemeStarts(s)
+	for _, start := range starts {
+		if start > pos {
+			return start
+		}
+	}
+	return starts[len(starts)-1]
+}
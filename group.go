@@ -1,11 +1,17 @@
 package huh
 
 import (
+	"fmt"
+	"math"
+	"sort"
 	"strings"
 
+	"github.com/charmbracelet/bubbles/cursor"
 	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/paginator"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
 )
 
 // Group is a collection of fields that are displayed together with a page of
@@ -14,6 +20,11 @@ import (
 //
 // If any of the fields in a group have errors, the form will not be able to
 // progress to the next group.
+//
+// Fields are focused in the order they are declared in, unless they have a tab
+// index. Fields with a positive tab index are focused first, in increasing
+// order, followed by the other fields in declaration order. Shift+tab goes
+// through the same order in reverse.
 type Group struct {
 	// collection of fields
 	fields []Field
@@ -26,17 +37,39 @@ type Group struct {
 	paginator paginator.Model
 
 	// help
-	showHelp bool
-	help     help.Model
+	showHelp    bool
+	groupedHelp bool
+	compactHelp bool
+	help        help.Model
 
 	// errors
-	showErrors bool
+	showErrors     bool
+	errorPlacement ErrorPlacement
+	validationMode ValidationMode
+	validate       func() error
+	err            error
+	failFast       bool
+
+	// whether answered fields collapse to a single line
+	inlineHistory bool
+
+	// whether the focused field is drawn in a border
+	focusedBorder bool
+
+	// whether the committed value of the focused field is shown below it
+	valueFooter bool
+
+	// whether the form's field search is shown in the help
+	fieldSearch bool
 
 	// group options
-	width  int
-	theme  *Theme
-	keymap *KeyMap
-	hide   func() bool
+	layout       Layout
+	width        int
+	widthPercent int
+	theme        *Theme
+	keymap       *KeyMap
+	strings      *Strings
+	hide         func() bool
 }
 
 // NewGroup returns a new group with the given fields.
@@ -71,19 +104,94 @@ func (g *Group) WithShowHelp(show bool) *Group {
 	return g
 }
 
+// WithGroupedHelp sets whether the group's help is split into sections for
+// navigation, editing and the form. The help is shown as usual where the
+// sections don't fit the width of the group.
+func (g *Group) WithGroupedHelp(v bool) *Group {
+	g.groupedHelp = v
+	return g
+}
+
+// WithCompactHelp sets whether the group's help is a single line legend of
+// the focused field's bindings and the form's, cut short with an ellipsis
+// where it's wider than the group. It takes precedence over grouped help.
+func (g *Group) WithCompactHelp(v bool) *Group {
+	g.compactHelp = v
+	return g
+}
+
 // WithShowErrors sets whether or not the group's errors should be shown.
 func (g *Group) WithShowErrors(show bool) *Group {
 	g.showErrors = show
 	return g
 }
 
+// WithInlineHistory sets whether the fields before the focused field should
+// collapse to a single "Title: value" line.
+func (g *Group) WithInlineHistory(v bool) *Group {
+	g.inlineHistory = v
+	return g
+}
+
+// WithCursorMode sets the default cursor mode of the text fields in a group.
+func (g *Group) WithCursorMode(mode cursor.Mode) *Group {
+	for _, field := range g.fields {
+		if f, ok := field.(cursorModer); ok {
+			f.withCursorMode(mode)
+		}
+	}
+	return g
+}
+
+// descriptionVisibilitySetter is implemented by fields with descriptions.
+type descriptionVisibilitySetter interface {
+	WithDescriptionVisibility(DescriptionVisibility) Field
+}
+
+// WithDescriptionVisibility sets when the descriptions of the fields in a
+// group are shown.
+func (g *Group) WithDescriptionVisibility(v DescriptionVisibility) *Group {
+	for _, field := range g.fields {
+		if f, ok := field.(descriptionVisibilitySetter); ok {
+			f.WithDescriptionVisibility(v)
+		}
+	}
+	return g
+}
+
+// cursorModer is implemented by fields with a text cursor.
+type cursorModer interface {
+	withCursorMode(cursor.Mode)
+}
+
 // WithTheme sets the theme on a group.
 func (g *Group) WithTheme(t *Theme) *Group {
 	g.theme = t
 	g.help.Styles = t.Help
+	if g.layout == LayoutMinimal {
+		t = t.minimal()
+	}
 	for _, field := range g.fields {
 		field.WithTheme(t)
 	}
+
+	// Field widths account for the frame of the theme, so they are
+	// recomputed when the theme changes.
+	if g.width > 0 {
+		g.WithWidth(g.width)
+	}
+	return g
+}
+
+// WithLayout sets the layout of a group.
+func (g *Group) WithLayout(layout Layout) *Group {
+	g.layout = layout
+	if g.theme != nil {
+		g.WithTheme(g.theme)
+	}
+	if g.width > 0 {
+		g.WithWidth(g.width)
+	}
 	return g
 }
 
@@ -96,15 +204,153 @@ func (g *Group) WithKeyMap(k *KeyMap) *Group {
 	return g
 }
 
+// stringsSetter is implemented by fields with user-facing strings. Fields
+// that don't implement it keep their own strings.
+type stringsSetter interface {
+	WithStrings(*Strings) Field
+}
+
+// WithStrings sets the user-facing strings on a group.
+func (g *Group) WithStrings(s *Strings) *Group {
+	g.strings = s
+	for _, field := range g.fields {
+		if f, ok := field.(stringsSetter); ok {
+			f.WithStrings(s)
+		}
+	}
+	return g
+}
+
 // WithWidth sets the width on a group.
+//
+// If the group has a width percentage, its fields take that percentage of the
+// given width.
 func (g *Group) WithWidth(width int) *Group {
 	g.width = width
+	if g.widthPercent > 0 {
+		width = percentWidth(width, g.widthPercent)
+	}
+	if column := g.errorColumn(); column > 0 {
+		width = min(width, g.width-column)
+	}
+	if g.focusedBorder {
+		width = max(width-g.borderStyle(false).GetHorizontalFrameSize(), 0)
+	}
 	for _, field := range g.fields {
 		field.WithWidth(width)
 	}
 	return g
 }
 
+// WithFocusedBorder sets whether the focused field of a group is drawn in a
+// border. The other fields keep the border's space blank so that they don't
+// move as the focus does, and the width of the fields leaves room for it.
+func (g *Group) WithFocusedBorder(v bool) *Group {
+	g.focusedBorder = v
+	if g.width > 0 {
+		g.WithWidth(g.width)
+	}
+	return g
+}
+
+// WithValueFooter sets whether the committed value of the focused field of a
+// group is shown in a line below it. Only fields with options that have a
+// value show the line, which updates as the value is chosen with Next.
+func (g *Group) WithValueFooter(v bool) *Group {
+	g.valueFooter = v
+	return g
+}
+
+// valueFooterView renders the line below the focused field showing its
+// committed value, or nothing if it has none.
+func (g *Group) valueFooterView(field Field) string {
+	if _, ok := field.(optionLister); !ok || !g.valueFooter {
+		return ""
+	}
+	value := displayValue(field)
+	if value == "" {
+		return ""
+	}
+	styles := g.theme.Focused
+	base := styles.Base
+	if g.layout == LayoutMinimal {
+		base = lipgloss.NewStyle()
+	}
+	return "\n" + base.Render(styles.Description.Render(fmt.Sprintf(g.strings.Current, value)))
+}
+
+// validationModer is implemented by fields that validate their values, whose
+// validation runs according to the validation mode of their group.
+type validationModer interface {
+	withValidationMode(ValidationMode)
+}
+
+// WithValidationMode sets when the validation of a group and its fields runs,
+// see Form.WithValidationMode.
+func (g *Group) WithValidationMode(mode ValidationMode) *Group {
+	g.validationMode = mode
+	for _, field := range g.fields {
+		if v, ok := field.(validationModer); ok {
+			v.withValidationMode(mode)
+		}
+	}
+	return g
+}
+
+// submitting makes the group and its fields validate as usual while the form
+// is submitted, even if validation is deferred until then. It returns a
+// function restoring the validation mode.
+func (g *Group) submitting() (restore func()) {
+	mode := g.validationMode
+	if mode != ValidationOnSubmit {
+		return func() {}
+	}
+	g.WithValidationMode(ValidationOnBlur)
+	return func() { g.WithValidationMode(mode) }
+}
+
+// WithErrorPlacement sets where the validation errors of a group's fields are
+// shown. Errors from the group's own validation are always listed below.
+func (g *Group) WithErrorPlacement(placement ErrorPlacement) *Group {
+	g.errorPlacement = placement
+	if g.width > 0 {
+		g.WithWidth(g.width)
+	}
+	return g
+}
+
+// errorColumn returns the width kept to the right of the group's fields for
+// their errors, which is a third of the group's width with
+// ErrorPlacementRight.
+func (g *Group) errorColumn() int {
+	if g.errorPlacement != ErrorPlacementRight {
+		return 0
+	}
+	return g.width / 3
+}
+
+// borderStyle returns the style of the border around a field, which is blank
+// unless the field is focused.
+func (g *Group) borderStyle(focused bool) lipgloss.Style {
+	style := lipgloss.NewStyle().Border(lipgloss.RoundedBorder())
+	if !focused {
+		return style.BorderStyle(lipgloss.HiddenBorder())
+	}
+	return style.BorderForeground(g.theme.Focused.Title.GetForeground())
+}
+
+// WithWidthPercent sets the fields of a group to take a percentage of the
+// group's width, which is usually the width of the terminal.
+//
+// The resulting width is at least 20 cells, unless the group is narrower.
+func (g *Group) WithWidthPercent(percent int) *Group {
+	g.widthPercent = clamp(percent, 0, 100)
+	if g.width > 0 {
+		g.WithWidth(g.width)
+	}
+	return g
+}
+
 // WithHide sets whether this group should be skipped.
 func (g *Group) WithHide(hide bool) *Group {
 	g.WithHideFunc(func() bool { return hide })
@@ -117,7 +363,53 @@ func (g *Group) WithHideFunc(hideFunc func() bool) *Group {
 	return g
 }
 
-// Errors returns the groups' fields' errors.
+// Validate sets a function that validates the group as a whole when leaving
+// it, for rules that span fields, such as an end date after a start date. The
+// values bound to the group's fields are up to date when it runs.
+//
+// The error is shown below the group's fields and keeps the form from moving
+// on, and the first field of the group is focused so that it can be fixed.
+func (g *Group) Validate(validate func() error) *Group {
+	g.validate = validate
+	return g
+}
+
+// runValidation runs the group's validation, if any, and returns its error.
+func (g *Group) runValidation() (err error) {
+	if g.validationMode == ValidationOnSubmit {
+		return nil
+	}
+	defer func() { g.err = err }()
+	defer recoverAsError(!g.failFast, &err)
+	if g.validate != nil {
+		err = g.validate()
+	}
+	return err
+}
+
+// validator is implemented by fields that validate their values.
+// validateField validates the field's current value, storing it first for
+// fields that store their value when blurred, so that forms can validate
+// fields without blurring them.
+type validator interface {
+	validateField()
+}
+
+// validateFields validates each of the group's fields and then the group, as
+// submitting does, even if validation is deferred until then.
+func (g *Group) validateFields() {
+	restore := g.submitting()
+	defer restore()
+	for _, field := range g.fields {
+		if v, ok := field.(validator); ok {
+			v.validateField()
+		}
+	}
+	g.runValidation()
+}
+
+// Errors returns the groups' fields' errors, followed by the error of the
+// group's validation, if any.
 func (g *Group) Errors() []error {
 	var errs []error
 	for _, field := range g.fields {
@@ -125,9 +417,202 @@ func (g *Group) Errors() []error {
 			errs = append(errs, err)
 		}
 	}
+	if g.err != nil {
+		errs = append(errs, g.err)
+	}
 	return errs
 }
 
+// firstError returns the position of the first field with an error, or of
+// the first field in tab order if only the group's own validation failed. It
+// is -1 if the group has no errors.
+func (g *Group) firstError() int {
+	for i, field := range g.fields {
+		if field.Error() != nil {
+			return i
+		}
+	}
+	if g.err != nil {
+		return g.tabOrder()[0]
+	}
+	return -1
+}
+
+// warner is implemented by fields with warnings, which don't block the form.
+type warner interface {
+	Warning() string
+}
+
+// Warnings returns the groups' fields' warnings.
+func (g *Group) Warnings() []string {
+	var warnings []string
+	for _, field := range g.fields {
+		w, ok := field.(warner)
+		if !ok {
+			continue
+		}
+		if warning := w.Warning(); warning != "" {
+			warnings = append(warnings, warning)
+		}
+	}
+	return warnings
+}
+
+// textEntry is implemented by fields that can take typed text. The form's
+// single key bindings, such as help, are disabled while they take text so
+// that they don't steal the keys.
+type textEntry interface {
+	enteringText() bool
+}
+
+// enteringText returns whether the focused field of the group takes typed
+// text.
+func (g *Group) enteringText() bool {
+	field, ok := g.fields[g.paginator.Page].(textEntry)
+	return ok && field.enteringText()
+}
+
+// helpView renders the help of the focused field, followed by the form's
+// help binding when it isn't disabled by text entry. The full help shows one
+// column of bindings per few bindings.
+func (g *Group) helpView() string {
+	binds := g.fields[g.paginator.Page].KeyBinds()
+	if g.compactHelp {
+		if g.keymap != nil {
+			if g.fieldSearch && !g.enteringText() && !g.fieldBinds(g.keymap.Search.Help().Key) {
+				binds = append(binds, g.keymap.Search)
+			}
+			binds = append(binds, g.keymap.Submit, g.keymap.Quit)
+		}
+		return g.compactHelpView(binds)
+	}
+	if g.groupedHelp {
+		if view := g.groupedHelpView(binds); view != "" {
+			return view
+		}
+	}
+	if g.keymap != nil && !g.enteringText() {
+		binds = append(binds, g.keymap.Help)
+		if g.fieldSearch && !g.fieldBinds(g.keymap.Search.Help().Key) {
+			binds = append(binds, g.keymap.Search)
+		}
+	}
+	if g.keymap != nil {
+		binds = append(binds, g.keymap.Submit)
+	}
+
+	if !g.help.ShowAll {
+		return g.help.ShortHelpView(binds)
+	}
+
+	var enabled []key.Binding
+	for _, bind := range binds {
+		if bind.Enabled() {
+			enabled = append(enabled, bind)
+		}
+	}
+
+	const columnHeight = 3
+	var columns [][]key.Binding
+	for i := 0; i < len(enabled); i += columnHeight {
+		columns = append(columns, enabled[i:min(i+columnHeight, len(enabled))])
+	}
+	return g.help.FullHelpView(columns)
+}
+
+// compactHelpView renders the enabled bindings with help as a single line,
+// dropping the bindings that don't fit the width of the group for an
+// ellipsis.
+func (g *Group) compactHelpView(binds []key.Binding) string {
+	styles := g.help.Styles
+	separator := styles.ShortSeparator.Inline(true).Render(g.help.ShortSeparator)
+	ellipsis := styles.Ellipsis.Inline(true).Render(g.help.Ellipsis)
+
+	var s strings.Builder
+	for _, bind := range binds {
+		help := bind.Help()
+		if !bind.Enabled() || help.Key == "" {
+			continue
+		}
+		item := styles.ShortKey.Inline(true).Render(help.Key) + " " +
+			styles.ShortDesc.Inline(true).Render(help.Desc)
+		if s.Len() > 0 {
+			item = separator + item
+		}
+		if g.width > 0 && lipgloss.Width(s.String()+item) > g.width {
+			if lipgloss.Width(s.String()+" "+ellipsis) <= g.width {
+				s.WriteString(" " + ellipsis)
+			}
+			break
+		}
+		s.WriteString(item)
+	}
+	return s.String()
+}
+
+// navigationKeys are the keys of bindings that belong in the navigation
+// section of grouped help.
+var navigationKeys = map[string]bool{
+	"up": true, "down": true, "left": true, "right": true,
+	"pgup": true, "pgdown": true, "home": true, "end": true,
+	"enter": true, "tab": true, "shift+tab": true,
+}
+
+// groupedHelpView renders the help of the focused field split into sections,
+// side by side. It returns an empty string if the sections don't fit the
+// width of the group.
+func (g *Group) groupedHelpView(binds []key.Binding) string {
+	var navigation, editing, form []key.Binding
+	for _, bind := range binds {
+		if !bind.Enabled() {
+			continue
+		}
+		isNavigation := false
+		for _, k := range bind.Keys() {
+			isNavigation = isNavigation || navigationKeys[k]
+		}
+		if isNavigation {
+			navigation = append(navigation, bind)
+		} else {
+			editing = append(editing, bind)
+		}
+	}
+	if g.keymap != nil && !g.enteringText() {
+		form = append(form, g.keymap.Copy)
+	}
+	if g.keymap != nil {
+		form = append(form, g.keymap.Submit)
+	}
+
+	title := g.help.Styles.FullDesc.Copy().Bold(true)
+	sections := []struct {
+		title string
+		binds []key.Binding
+	}{
+		{g.strings.HelpNavigation, navigation},
+		{g.strings.HelpEditing, editing},
+		{g.strings.HelpForm, form},
+	}
+
+	var columns []string
+	for _, section := range sections {
+		if len(section.binds) <= 0 {
+			continue
+		}
+		column := title.Render(section.title) + "\n" + g.help.FullHelpView([][]key.Binding{section.binds})
+		columns = append(columns, lipgloss.NewStyle().PaddingRight(helpSectionGap).Render(column))
+	}
+
+	view := lipgloss.JoinHorizontal(lipgloss.Top, columns...)
+	if g.width > 0 && lipgloss.Width(view) > g.width {
+		return ""
+	}
+	return view
+}
+
+// helpSectionGap is the space between the sections of grouped help.
+const helpSectionGap = 4
+
 // nextFieldMsg is a message to move to the next field,
 //
 // each field controls when to send this message such that it is able to use
@@ -156,11 +641,50 @@ func (g *Group) Init() tea.Cmd {
 	for i, field := range g.fields {
 		cmds[i] = field.Init()
 	}
+	g.paginator.Page = g.tabOrder()[0]
 	cmd := g.fields[g.paginator.Page].Focus()
 	cmds = append(cmds, cmd)
 	return tea.Batch(cmds...)
 }
 
+// tabIndexer is implemented by fields with a tab index.
+type tabIndexer interface {
+	getTabIndex() int
+}
+
+// tabOrder returns the indices of the group's fields in the order they are
+// focused. Fields with a positive tab index come first, in increasing order,
+// followed by the other fields in declaration order.
+func (g *Group) tabOrder() []int {
+	rank := func(i int) int {
+		if field, ok := g.fields[i].(tabIndexer); ok && field.getTabIndex() > 0 {
+			return field.getTabIndex()
+		}
+		return math.MaxInt
+	}
+
+	order := make([]int, len(g.fields))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return rank(order[a]) < rank(order[b])
+	})
+	return order
+}
+
+// tabPosition returns the tab order of the group and the position of the
+// current field in it.
+func (g *Group) tabPosition() ([]int, int) {
+	order := g.tabOrder()
+	for pos, i := range order {
+		if i == g.paginator.Page {
+			return order, pos
+		}
+	}
+	return order, 0
+}
+
 // setCurrent sets the current field.
 func (g *Group) setCurrent(current int) tea.Cmd {
 	var (
@@ -179,21 +703,53 @@ func (g *Group) setCurrent(current int) tea.Cmd {
 	return tea.Batch(cmds...)
 }
 
+// broadcastMsg is implemented by messages for fields wherever they are in the
+// form, rather than for the focused field, such as the results of the
+// background work of a field. The form sends them to all fields.
+type broadcastMsg interface {
+	broadcast()
+}
+
+// bindingsUpdater is implemented by fields that depend on the values of other
+// fields. They are updated whenever another field of the group may have
+// changed.
+type bindingsUpdater interface {
+	updateBindings() tea.Cmd
+}
+
 // Update updates the group.
 func (g *Group) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	var cmds []tea.Cmd
 
+	if _, ok := msg.(broadcastMsg); ok {
+		for _, field := range g.fields {
+			_, cmd := field.Update(msg)
+			cmds = append(cmds, cmd)
+		}
+		return g, tea.Batch(cmds...)
+	}
+
 	m, cmd := g.fields[g.paginator.Page].Update(msg)
 	g.fields[g.paginator.Page] = m.(Field)
 
 	cmds = append(cmds, cmd)
 
+	for i, field := range g.fields {
+		if u, ok := field.(bindingsUpdater); ok && i != g.paginator.Page {
+			cmds = append(cmds, u.updateBindings())
+		}
+	}
+
 	switch msg.(type) {
 	case nextFieldMsg:
-		current := g.paginator.Page
-		cmd = g.setCurrent(current + 1)
-
-		if current >= g.paginator.TotalPages-1 {
+		order, pos := g.tabPosition()
+		cmd = g.setCurrent(order[min(pos+1, len(order)-1)])
+
+		if pos >= len(order)-1 {
+			if g.runValidation() != nil {
+				cmds = append(cmds, g.setCurrent(order[0]))
+				break
+			}
 			cmds = append(cmds, nextGroup)
 			break
 		}
@@ -201,10 +757,10 @@ func (g *Group) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		cmds = append(cmds, cmd)
 
 	case prevFieldMsg:
-		current := g.paginator.Page
-		cmd = g.setCurrent(current - 1)
+		order, pos := g.tabPosition()
+		cmd = g.setCurrent(order[max(pos-1, 0)])
 
-		if current == 0 {
+		if pos == 0 {
 			cmds = append(cmds, prevGroup)
 			break
 		}
@@ -224,18 +780,50 @@ func (g *Group) View() string {
 		gap = "\n"
 	}
 
+	// Fields before the current one in the tab order are answered.
+	order, current := g.tabPosition()
+	answered := make(map[int]bool, current)
+	for _, i := range order[:current] {
+		answered[i] = true
+	}
+
 	for i, field := range g.fields {
-		s.WriteString(field.View())
+		if g.inlineHistory && answered[i] {
+			s.WriteString(g.inlineView(field) + "\n")
+			continue
+		}
+		view := field.View()
+		if g.focusedBorder {
+			view = g.borderStyle(i == g.paginator.Page).Render(view)
+		}
+		if i == g.paginator.Page {
+			view += g.valueFooterView(field)
+		}
+		s.WriteString(g.fieldErrorView(view, field.Error()))
 		if i < len(g.fields)-1 {
 			s.WriteString(gap)
 		}
 	}
 
 	errors := g.Errors()
-	s.WriteString(gap)
+	if g.errorPlacement != ErrorPlacementBelow {
+		errors = nil
+		if g.err != nil {
+			errors = []error{g.err}
+		}
+	}
+	warnings := g.Warnings()
 
-	if g.showHelp && len(errors) <= 0 {
-		s.WriteString(g.help.ShortHelpView(g.fields[g.paginator.Page].KeyBinds()))
+	if g.layout == LayoutMinimal {
+		if !g.showErrors || len(errors)+len(warnings) <= 0 {
+			return s.String()
+		}
+		s.WriteString("\n")
+	} else {
+		s.WriteString(gap)
+		if g.showHelp && len(errors) <= 0 {
+			s.WriteString(g.helpView())
+		}
 	}
 
 	if !g.showErrors {
@@ -247,5 +835,55 @@ func (g *Group) View() string {
 		s.WriteString("\n")
 	}
 
+	for _, warning := range warnings {
+		s.WriteString(g.theme.Focused.WarningMessage.Render(warning))
+		s.WriteString("\n")
+	}
+
+	return s.String()
+}
+
+// fieldErrorView places the error of a field next to its view, unless errors
+// are listed below the group.
+func (g *Group) fieldErrorView(view string, err error) string {
+	if err == nil || !g.showErrors || g.errorPlacement == ErrorPlacementBelow {
+		return view
+	}
+	style := g.theme.Focused.ErrorMessage.Copy()
+
+	if g.errorPlacement == ErrorPlacementRight {
+		remaining := g.width - lipgloss.Width(view) - 1
+		if g.width <= 0 || remaining > 0 {
+			if remaining > 0 {
+				style = style.Width(remaining)
+			}
+			return lipgloss.JoinHorizontal(lipgloss.Top, view, " ", style.Render(err.Error()))
+		}
+	}
+
+	// Tooltips, and errors with no room to the right, go in a box below.
+	style = style.Border(lipgloss.RoundedBorder()).
+		BorderForeground(style.GetForeground()).
+		Padding(0, 1)
+	width := lipgloss.Width(view)
+	if lipgloss.Width(err.Error())+style.GetHorizontalFrameSize() > width {
+		style = style.Width(max(width-style.GetHorizontalBorderSize(), 1))
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, view, style.Render(err.Error()))
+}
+
+// inlineView renders a field as a single "Title: value" line.
+func (g *Group) inlineView(field Field) string {
+	styles := g.theme.Blurred
+	value, _, _ := strings.Cut(displayValue(field), "\n")
+	return styles.Base.Render(styles.Title.Render(fieldTitle(field)+":") + " " + styles.SelectedOption.Render(value))
+}
+
+// historyView renders all of the group's fields as single lines.
+func (g *Group) historyView() string {
+	var s strings.Builder
+	for _, field := range g.fields {
+		s.WriteString(g.inlineView(field) + "\n")
+	}
 	return s.String()
 }
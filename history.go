@@ -0,0 +1,58 @@
+package huh
+
+import "sync"
+
+// historyLimit is the number of recent entries an input field keeps.
+const historyLimit = 100
+
+// HistoryStore loads and saves the recent values of an input field, see
+// Input.WithHistory. Entries are ordered from oldest to newest.
+//
+// Implement it to keep history across runs, such as in a file.
+type HistoryStore interface {
+	Load() ([]string, error)
+	Save(entries []string) error
+}
+
+// MemoryHistory is a HistoryStore that keeps entries in memory, for history
+// that lasts as long as the program.
+type MemoryHistory struct {
+	mu      sync.Mutex
+	entries []string
+}
+
+// NewMemoryHistory returns a new in-memory history with the given entries.
+func NewMemoryHistory(entries ...string) *MemoryHistory {
+	return &MemoryHistory{entries: entries}
+}
+
+// Load returns the entries of the history.
+func (h *MemoryHistory) Load() ([]string, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return append([]string(nil), h.entries...), nil
+}
+
+// Save replaces the entries of the history.
+func (h *MemoryHistory) Save(entries []string) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.entries = append([]string(nil), entries...)
+	return nil
+}
+
+// addEntry returns entries with entry added as the newest, moving it there if
+// it was already present and dropping the oldest beyond historyLimit.
+func addEntry(entries []string, entry string) []string {
+	kept := make([]string, 0, len(entries)+1)
+	for _, e := range entries {
+		if e != entry {
+			kept = append(kept, e)
+		}
+	}
+	kept = append(kept, entry)
+	if len(kept) > historyLimit {
+		kept = kept[len(kept)-historyLimit:]
+	}
+	return kept
+}
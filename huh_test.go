@@ -1,10 +1,20 @@
 package huh
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"regexp"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/charmbracelet/bubbles/cursor"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/spinner"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 )
@@ -268,6 +278,81 @@ func TestForm(t *testing.T) {
 	// TODO: Finish and submit form.
 }
 
+func TestToScript(t *testing.T) {
+	name := "Glen"
+	f := NewForm(
+		NewGroup(
+			NewNote().Title("Welcome"),
+			NewInput().Key("name").Title("Name").Value(&name),
+			NewSelect[int]().Key("size").Options(NewOptions(1, 2, 3)...),
+			NewConfirm().Title("Unkeyed"),
+		),
+	)
+
+	want := `# Name
+# string
+name = "Glen"
+
+# int, one of: 1, 2, 3
+size = 0
+`
+	if got := f.ToScript(); got != want {
+		t.Errorf("Expected answer-file template:\n%s\ngot:\n%s", want, got)
+	}
+}
+
+func TestNoteImage(t *testing.T) {
+	t.Setenv("KITTY_WINDOW_ID", "")
+	t.Setenv("TERM", "dumb")
+	t.Setenv("TERM_PROGRAM", "")
+
+	path := t.TempDir() + "/logo.png"
+	if err := os.WriteFile(path, []byte("png"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	note := NewNote().Title("Welcome").Image(path).ImageAlt("Charm logo")
+	f := NewForm(NewGroup(note))
+	f.Update(f.Init())
+
+	view := f.View()
+	if !strings.Contains(view, "Charm logo") || strings.Contains(view, "\x1b_G") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected the alt text on terminals without graphics support.")
+	}
+
+	t.Setenv("TERM", "xterm-kitty")
+	other := NewNote().Title("Again").Image(path)
+	f = NewForm(NewGroup(note, other)).WithWidth(40)
+	f.Update(f.Init())
+	view = f.View()
+	seq := regexp.MustCompile("\x1b_Gf=100,t=f,a=T,i=(\\d+),[^\x1b]*\x1b\\\\")
+	ids := seq.FindAllStringSubmatch(view, -1)
+	if len(ids) != 2 || ids[0][1] == ids[1][1] || strings.Contains(view, "Charm logo") {
+		t.Log(pretty.Render(view))
+		t.Fatalf("Expected an image with its own id for each note, got %q", ids)
+	}
+	for _, line := range strings.Split(seq.ReplaceAllString(view, ""), "\n") {
+		if w := lipgloss.Width(line); w > 40 {
+			t.Errorf("Expected the image not to take up width, got a line of %d", w)
+		}
+	}
+
+	escapes.Lock()
+	deferred := len(escapes.list)
+	escapes.Unlock()
+	for i := 0; i < 3; i++ {
+		other.Image(path)
+		Link("docs", "https://example.com")
+		f.View()
+	}
+	escapes.Lock()
+	defer escapes.Unlock()
+	if len(escapes.list) > deferred+2 {
+		t.Errorf("Expected images and links set again to reuse their escapes, got %d more", len(escapes.list)-deferred)
+	}
+}
+
 func TestInput(t *testing.T) {
 	field := NewInput()
 	f := NewForm(NewGroup(field))
@@ -296,6 +381,109 @@ func TestInput(t *testing.T) {
 	}
 }
 
+func TestWithoutPadding(t *testing.T) {
+	plain := NewInput().Title("Plain")
+	f := NewForm(NewGroup(plain, NewInput().Title("Padded")))
+	plain.WithoutPadding(true)
+	f.Update(f.Init())
+
+	view := f.View()
+	if !strings.Contains(view, "\nPlain") && !strings.HasPrefix(view, "Plain") || !strings.Contains(view, "  Padded") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected only the first field to be rendered without padding.")
+	}
+	if f.theme.Focused.Base.GetHorizontalFrameSize() == 0 {
+		t.Error("Expected the theme to be unchanged.")
+	}
+}
+
+func TestUpdateHook(t *testing.T) {
+	field := NewInput()
+	field.WithUpdateHook(func(msg tea.Msg) tea.Msg {
+		if msg, ok := msg.(tea.KeyMsg); ok {
+			switch msg.String() {
+			case "a":
+				return keys('A')
+			case "z":
+				return nil
+			}
+		}
+		return msg
+	})
+	f := NewForm(NewGroup(field))
+	f.Update(f.Init())
+	f.Update(keys('a'))
+	f.Update(keys('z'))
+	f.Update(keys('b'))
+
+	view := f.View()
+	if !strings.Contains(view, "Ab") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected the hook to translate a and consume z.")
+	}
+}
+
+func TestInputStrengthMeter(t *testing.T) {
+	field := NewInput().Title("Password").Password(true).WithStrengthMeter(func(s string) int {
+		return len(s)
+	})
+	f := NewForm(NewGroup(field))
+	f.Update(f.Init())
+
+	view := f.View()
+	if !strings.Contains(view, "▱▱▱▱ Very weak") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected empty strength meter.")
+	}
+
+	m, _ := f.Update(keys('a'))
+	m, _ = m.Update(keys('b'))
+	m, _ = m.Update(keys('c'))
+	view = m.View()
+
+	if !strings.Contains(view, "▰▰▰▱ Good") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected strength meter to update as the user types.")
+	}
+
+	for _, r := range "defg" {
+		m, _ = m.Update(keys(r))
+	}
+	view = m.View()
+
+	if !strings.Contains(view, "▰▰▰▰ Strong") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected strength score to be clamped.")
+	}
+}
+
+func TestInputTransform(t *testing.T) {
+	var value string
+	field := NewInput().Value(&value).Transform(func(s string) string {
+		return strings.ToUpper(strings.ReplaceAll(s, " ", ""))
+	}).CharLimit(5)
+	f := NewForm(NewGroup(field))
+	f.Update(f.Init())
+
+	var m tea.Model = f
+	for _, r := range "huh ok!" {
+		m, _ = m.Update(keys(r))
+	}
+
+	if value != "HUHOK" {
+		t.Errorf("Expected value to be transformed and limited, got %q", value)
+	}
+
+	// Insert before the last character to check the cursor is preserved.
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyLeft})
+	m.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+	m.Update(keys('x'))
+
+	if value != "HUHXK" {
+		t.Errorf("Expected transformed insert to keep the cursor position, got %q", value)
+	}
+}
+
 func TestText(t *testing.T) {
 	field := NewText()
 	f := NewForm(NewGroup(field))
@@ -348,6 +536,86 @@ func TestConfirm(t *testing.T) {
 	}
 }
 
+func TestStrings(t *testing.T) {
+	german := DefaultStrings()
+	german.Affirmative = "Ja"
+	german.Negative = "Nein"
+	german.Next = "Weiter"
+
+	f := NewForm(
+		NewGroup(
+			NewConfirm().Title("Sicher?"),
+			NewConfirm().Title("Wirklich?").Affirmative("Jawohl"),
+			NewNote().Title("Notiz").Next(true),
+		),
+	).WithStrings(german)
+	f.Update(f.Init())
+
+	view := f.View()
+
+	for _, label := range []string{"Ja", "Nein", "Jawohl", "Weiter"} {
+		if !strings.Contains(view, label) {
+			t.Log(pretty.Render(view))
+			t.Errorf("Expected form to contain %s.", label)
+		}
+	}
+}
+
+func TestEnumerators(t *testing.T) {
+	s := DefaultStrings()
+	if got := s.formatOption(2, "Baz"); got != "3. Baz" {
+		t.Errorf("Expected numbered option, got %q", got)
+	}
+
+	s.Enumerator = LetterEnumerator
+	s.OptionFormat = func(label, text string) string { return text + " ." + label }
+	if got := s.formatOption(2, "Baz"); got != "Baz .c" {
+		t.Errorf("Expected lettered right-to-left option, got %q", got)
+	}
+
+	if got := LetterEnumerator(26); got != "27" {
+		t.Errorf("Expected letters to fall back to numbers, got %q", got)
+	}
+
+	for input, want := range map[string]int{"3": 3, "c": 3, "C": 3, " a ": 1, "27": 27} {
+		if got, err := parseChoice(input); err != nil || got != want {
+			t.Errorf("Expected %q to parse as %d, got %d (%v)", input, want, got, err)
+		}
+	}
+
+	if _, err := parseChoice("ab"); err == nil {
+		t.Error("Expected multiple letters to be invalid")
+	}
+}
+
+func TestConfirmRequirePhrase(t *testing.T) {
+	var confirmed bool
+	field := NewConfirm().Title("Delete repository?").RequirePhrase("huh").Value(&confirmed)
+	f := NewForm(NewGroup(field))
+	f.Update(f.Init())
+
+	m, _ := f.Update(keys('h', 'u'))
+	if confirmed {
+		t.Error("Expected confirm to be blocked until the phrase is typed.")
+	}
+
+	view := m.View()
+	if strings.Contains(view, "toggle") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected toggle to be unavailable while typing the phrase.")
+	}
+
+	m, _ = m.Update(keys('h'))
+	if !confirmed {
+		t.Error("Expected confirm to be affirmative once the phrase is typed.")
+	}
+
+	m.Update(keys('l'))
+	if confirmed {
+		t.Error("Expected confirm to be negative once the phrase mismatches.")
+	}
+}
+
 func TestSelect(t *testing.T) {
 	field := NewSelect[string]().Options(NewOptions("Foo", "Bar", "Baz")...).Title("Which one?")
 	f := NewForm(NewGroup(field))
@@ -392,139 +660,3633 @@ func TestSelect(t *testing.T) {
 	}
 }
 
-func TestMultiSelect(t *testing.T) {
-	field := NewMultiSelect[string]().Options(NewOptions("Foo", "Bar", "Baz")...).Title("Which one?")
+func TestSelectPaging(t *testing.T) {
+	field := NewSelect[int]().Options(NewOptions(1, 2, 3, 4, 5, 6, 7, 8, 9, 10)...).Title("Which one?").Height(3)
 	f := NewForm(NewGroup(field))
 	f.Update(f.Init())
 
 	view := f.View()
+	if !strings.Contains(view, "> 1") || strings.Contains(view, "4") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected only the first page of options to be visible.")
+	}
 
-	if !strings.Contains(view, "Foo") {
+	if !strings.Contains(view, "pgdn page down") {
 		t.Log(pretty.Render(view))
-		t.Error("Expected field to contain Foo.")
+		t.Error("Expected help to contain paging keybindings.")
 	}
 
-	if !strings.Contains(view, "Which one?") {
+	m, _ := f.Update(tea.KeyMsg{Type: tea.KeyPgDown})
+	view = m.View()
+	if !strings.Contains(view, "> 4") || strings.Contains(view, "  1") {
 		t.Log(pretty.Render(view))
-		t.Error("Expected field to contain Which one?.")
+		t.Error("Expected cursor to move down a page and scroll.")
 	}
 
-	if !strings.Contains(view, "> • Foo") {
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnd})
+	view = m.View()
+	if !strings.Contains(view, "> 10") {
 		t.Log(pretty.Render(view))
-		t.Error("Expected cursor to be on Foo.")
+		t.Error("Expected cursor to move to the last option.")
+	}
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyPgUp})
+	view = m.View()
+	if !strings.Contains(view, "> 7") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected cursor to move up a page.")
+	}
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyHome})
+	view = m.View()
+	if !strings.Contains(view, "> 1") || strings.Contains(view, "7") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected cursor to move to the first option and scroll.")
+	}
+}
+
+func TestSelectBlurredSummary(t *testing.T) {
+	f := NewForm(NewGroup(
+		NewSelect[string]().Options(NewOptions("Foo", "Bar", "Baz")...).Title("First").WithBlurredSummary(true),
+		NewSelect[string]().Options(NewOptions("Qux", "Quux")...).Title("Second").WithBlurredSummary(true),
+	))
+	f.Update(f.Init())
+
+	view := f.View()
+	if !strings.Contains(view, "> Foo") || !strings.Contains(view, "Second: Qux") || strings.Contains(view, "Quux") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected only the focused select to be expanded.")
 	}
 
-	// Move selection cursor down
 	m, _ := f.Update(keys('j'))
+	m = batchUpdate(m.Update(tea.KeyMsg{Type: tea.KeyEnter}))
 	view = m.View()
+	if !strings.Contains(view, "First: Bar") || !strings.Contains(view, "> Qux") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected the answered select to collapse to its value.")
+	}
+}
 
-	if strings.Contains(view, "> • Foo") {
+func TestSelectFilterKeepsSelection(t *testing.T) {
+	field := NewSelect[string]().Options(NewOptions("Apple", "Banana", "Cherry", "Date", "Elderberry")...).Title("Fruit")
+	f := NewForm(NewGroup(field))
+	f.Update(f.Init())
+
+	m, _ := f.Update(tea.KeyMsg{Type: tea.KeyDown})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	m, _ = m.Update(keys('/'))
+	m, _ = m.Update(keys('e'))
+	view := m.View()
+
+	if !strings.Contains(view, "> Cherry") {
 		t.Log(pretty.Render(view))
-		t.Error("Expected cursor to be on Bar.")
+		t.Error("Expected cursor to stay on the selected option while filtering.")
 	}
 
-	if !strings.Contains(view, "> • Bar") {
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	view = m.View()
+
+	if !strings.Contains(view, "> Cherry") {
 		t.Log(pretty.Render(view))
-		t.Error("Expected cursor to be on Bar.")
+		t.Error("Expected cursor to be restored to the selected option after clearing the filter.")
 	}
 
-	// Toggle
-	m, _ = f.Update(keys('x'))
+	m, _ = m.Update(keys('/'))
+	m, _ = m.Update(keys('n'))
+	m, _ = m.Update(keys('a'))
 	view = m.View()
 
-	if !strings.Contains(view, "> ✓ Bar") {
+	if !strings.Contains(view, "> Banana") {
 		t.Log(pretty.Render(view))
-		t.Error("Expected cursor to be on Bar.")
+		t.Error("Expected cursor to fall back to the first option when the selected option is filtered out.")
 	}
 
-	if !strings.Contains(view, "x toggle • ↑ up • ↓ down • enter confirm • shift+tab back") {
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	view = m.View()
+
+	if !strings.Contains(view, "> Cherry") || !strings.Contains(view, "Elderberry") {
 		t.Log(pretty.Render(view))
-		t.Error("Expected field to contain help.")
+		t.Error("Expected cursor to be restored to the option selected before filtering after clearing the filter.")
 	}
 }
 
-func TestHideGroup(t *testing.T) {
-	f := NewForm(
-		NewGroup(NewNote().Description("Foo")).WithHide(true),
-		NewGroup(NewNote().Description("Bar")),
-		NewGroup(NewNote().Description("Baz")),
-		NewGroup(NewNote().Description("Qux")).WithHideFunc(func() bool { return false }).WithHide(true),
-	)
+func TestSelectDefault(t *testing.T) {
+	field := NewSelect[string]().Options(NewOptions("Foo", "Bar", "Baz")...).Default("Bar").Title("Which one?")
+	f := NewForm(NewGroup(field))
+	f.Update(f.Init())
 
-	f = batchUpdate(f, f.Init()).(*Form)
+	view := f.View()
 
-	if v := f.View(); !strings.Contains(v, "Bar") {
-		t.Log(pretty.Render(v))
-		t.Error("expected Bar to not be hidden")
+	if !strings.Contains(view, "> Bar (default)") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected cursor to start on the tagged default option.")
 	}
 
-	f.Update(nextGroup())
+	m, _ := f.Update(tea.KeyMsg{Type: tea.KeyDown})
+	view = m.View()
 
-	if v := f.View(); !strings.Contains(v, "Baz") {
-		t.Log(pretty.Render(v))
-		t.Error("expected Baz to not be hidden")
+	if !strings.Contains(view, "> Baz") || !strings.Contains(view, "Bar (default)") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected default tag to stay on its option when the cursor moves.")
 	}
+}
 
-	f = batchUpdate(f, nextGroup).(*Form)
+func TestSelectTooltip(t *testing.T) {
+	field := NewSelect[string]().Options(
+		NewOption("Foo", "foo").Description("The first one."),
+		NewOption("Bar", "bar"),
+		NewOption("Ice", "ice").Accelerator('i'),
+	).Title("Which one?")
+	f := NewForm(NewGroup(field))
+	f.Update(f.Init())
 
-	if v := f.View(); strings.Contains(v, "Qux") {
-		t.Log(pretty.Render(v))
-		t.Error("expected Qux to be hidden")
+	if !strings.Contains(f.View(), "i info") {
+		t.Log(pretty.Render(f.View()))
+		t.Error("Expected tooltip help for an option with a description.")
+	}
+
+	m, _ := f.Update(keys('i'))
+	view := m.View()
+
+	if !strings.Contains(view, "The first one.") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected tooltip to show the option's description.")
+	}
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	view = m.View()
+
+	if strings.Contains(view, "The first one.") || !strings.Contains(view, "> Foo") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected any key to dismiss the tooltip without moving the cursor.")
+	}
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	view = m.View()
+
+	if strings.Contains(view, "i info") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected no tooltip help for an option without a description.")
+	}
+
+	m, _ = m.Update(keys('i'))
+	if view := m.View(); !strings.Contains(view, "> Ice") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected the tooltip key to be left to the accelerator without a description.")
 	}
 }
 
-func TestNote(t *testing.T) {
-	field := NewNote().Title("Taco").Description("How may we take your order?").Next(true)
+func TestSelectColumns(t *testing.T) {
+	field := NewSelect[string]().Options(NewOptions("A", "B", "C", "D", "E")...).Title("Which one?").Columns(2)
 	f := NewForm(NewGroup(field))
-	f.theme.Focused.Base.Border(lipgloss.HiddenBorder())
 	f.Update(f.Init())
 
 	view := f.View()
+	if !strings.Contains(view, "> A    D") || !strings.Contains(view, "  B    E") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected options to flow into columns from top to bottom.")
+	}
 
-	if !strings.Contains(view, "Taco") {
-		t.Log(view)
-		t.Error("Expected field to contain Taco title.")
+	m, _ := f.Update(tea.KeyMsg{Type: tea.KeyRight})
+	if !strings.Contains(m.View(), "> D") {
+		t.Log(pretty.Render(m.View()))
+		t.Error("Expected right to move to the next column.")
 	}
 
-	if !strings.Contains(view, "order?") {
-		t.Log(view)
-		t.Error("Expected field to contain Taco description.")
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyLeft})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRight})
+	if !strings.Contains(m.View(), "> E") {
+		t.Log(pretty.Render(m.View()))
+		t.Error("Expected right to move to the end of a shorter last column.")
 	}
 
-	if !strings.Contains(view, "Next") {
-		t.Log(view)
-		t.Error("Expected field to contain next button")
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyLeft})
+	if !strings.Contains(m.View(), "> B") {
+		t.Log(pretty.Render(m.View()))
+		t.Error("Expected left to move to the previous column.")
 	}
 
-	if !strings.Contains(view, "enter next") {
-		t.Log(view)
-		t.Error("Expected field to contain help.")
+	m, _ = m.Update(keys('/'))
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRight})
+	if !strings.Contains(m.View(), "> B") {
+		t.Log(pretty.Render(m.View()))
+		t.Error("Expected right to stay in the filter while filtering.")
+	}
+
+	// Without columns, h and l are left to accelerators.
+	field = NewSelect[string]().Options(NewOption("A", "a"), NewOption("Lime", "lime").Accelerator('l')).Title("Which one?")
+	f = NewForm(NewGroup(field))
+	f.Update(f.Init())
+	if m, _ = f.Update(keys('l')); !strings.Contains(m.View(), "> Lime") {
+		t.Log(pretty.Render(m.View()))
+		t.Error("Expected l to choose its accelerator without columns.")
 	}
 }
 
-func batchUpdate(m tea.Model, cmd tea.Cmd) tea.Model {
-	if cmd == nil {
-		return m
+func TestSelectFormatOption(t *testing.T) {
+	var value int
+	field := NewSelect[int]().Options(NewOptions(1, 2, 3)...).Title("How many?").Value(&value).
+		FormatOption(func(n int) string { return strings.Repeat("*", n) })
+	f := NewForm(NewGroup(field))
+	f.Update(f.Init())
+
+	view := f.View()
+	if !strings.Contains(view, "> *") || !strings.Contains(view, "***") || strings.Contains(view, "1") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected options to be formatted.")
 	}
 
-	msg := cmd()
-	if msg == nil {
-		return m
+	f.Update(keys('j'))
+	f.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if value != 2 || field.DisplayValue() != "**" {
+		t.Errorf("Expected value 2 shown as **, got %d shown as %q", value, field.DisplayValue())
 	}
+}
 
-	switch msg := msg.(type) {
-	case tea.BatchMsg:
-		for _, c := range msg {
-			m, cmd = m.Update(c())
-			if cmd == nil {
-				continue
-			}
-		}
-		return batchUpdate(m, cmd)
+func TestTree(t *testing.T) {
+	var value string
+	field := NewTree[string]().Options(
+		NewOption("Images", "").Children(
+			NewOption("PNG", "png"),
+			NewOption("JPEG", "jpeg"),
+		),
+		NewOption("Documents", "").Children(
+			NewOption("PDF", "pdf"),
+		),
+	).Title("File type").Value(&value)
+	f := NewForm(NewGroup(field))
+	f.Update(f.Init())
+
+	view := f.View()
+	if !strings.Contains(view, "> Images ›") || strings.Contains(view, "PNG") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected the top level with branches marked.")
 	}
 
-	m, cmd = m.Update(msg)
-	return batchUpdate(m, cmd)
+	m, _ := f.Update(tea.KeyMsg{Type: tea.KeyRight})
+	m, _ = m.Update(keys('j'))
+	view = m.View()
+	if !strings.Contains(view, "Images") || !strings.Contains(view, "> JPEG") || strings.Contains(view, "Documents") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected right to open the branch with a breadcrumb.")
+	}
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+	view = m.View()
+	if !strings.Contains(view, "> Images") || strings.Contains(view, "JPEG") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected backspace to return to the parent level.")
+	}
+
+	m, _ = m.Update(keys('j'))
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if value != "pdf" || field.DisplayValue() != "Documents › PDF" {
+		t.Errorf("Expected enter to open the branch and then choose pdf, got %q", value)
+	}
+}
+
+func TestSelectNumbering(t *testing.T) {
+	options := NewOptions("A", "B", "C", "D", "E", "F", "G", "H", "I", "J")
+	field := NewSelect[string]().Options(options...).Title("Which one?").WithNumbering(true)
+	f := NewForm(NewGroup(field))
+	f.Update(f.Init())
+
+	view := f.View()
+	if !strings.Contains(view, "> 1.  A") || !strings.Contains(view, "  10. J") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected options to be numbered and aligned.")
+	}
+
+	m, _ := f.Update(keys('3'))
+	if !strings.Contains(m.View(), "> 3.  C") {
+		t.Log(pretty.Render(m.View()))
+		t.Error("Expected typing a number to move to the option.")
+	}
+}
+
+func TestSelectScrollbar(t *testing.T) {
+	options := NewOptions("A", "B", "C", "D", "E", "F", "G", "H")
+	field := NewSelect[string]().Options(options...).Title("Which one?").Height(4).WithScrollbar(true)
+	f := NewForm(NewGroup(field))
+	f.Update(f.Init())
+
+	view := f.View()
+	if !strings.Contains(view, "> A ┃") || !strings.Contains(view, "  B ┃") || !strings.Contains(view, "  D │") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected a scrollbar with the thumb at the top.")
+	}
+
+	for i := 0; i < 7; i++ {
+		f.Update(keys('j'))
+	}
+	view = f.View()
+	if !strings.Contains(view, "  E │") || !strings.Contains(view, "> H ┃") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected the thumb to move to the bottom.")
+	}
+
+	f = NewForm(NewGroup(NewSelect[string]().Options(options[:3]...).Height(4).WithScrollbar(true)))
+	f.Update(f.Init())
+	if view := f.View(); strings.Contains(view, "│") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected no scrollbar when all options fit.")
+	}
+}
+
+type size int
+
+const (
+	small size = iota
+	medium
+	large
+)
+
+func (s size) String() string {
+	return [...]string{"Small", "Medium", "Large"}[s]
+}
+
+func TestSelectEnum(t *testing.T) {
+	value := medium
+	field := NewSelectEnum(small, medium, large).Title("Size?").Value(&value)
+	f := NewForm(NewGroup(field))
+	f.Update(f.Init())
+
+	view := f.View()
+	if !strings.Contains(view, "  Small") || !strings.Contains(view, "> Medium") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected options keyed by String with the value selected.")
+	}
+
+	f.Update(keys('j'))
+	f.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if value != large {
+		t.Errorf("Expected value to be large, got %s", value)
+	}
+}
+
+func TestSelectAccelerator(t *testing.T) {
+	var value string
+	field := NewSelect[string]().Options(
+		NewOption("Keep", "keep").Accelerator('k'),
+		NewOption("Archive", "archive").Accelerator('a'),
+		NewOption("Delete", "delete").Accelerator('d'),
+	).Title("What now?").Value(&value)
+	f := NewForm(NewGroup(field))
+	f.Update(f.Init())
+
+	f.Update(keys('d'))
+	if value != "delete" || !strings.Contains(f.View(), "> Delete") {
+		t.Log(pretty.Render(f.View()))
+		t.Errorf("Expected d to choose Delete, got %q", value)
+	}
+
+	f.Update(keys('k'))
+	if value != "delete" || !strings.Contains(f.View(), "> Archive") {
+		t.Log(pretty.Render(f.View()))
+		t.Error("Expected k to keep moving up rather than choose Keep.")
+	}
+
+	field.AdvanceOnAccelerator(true)
+	_, cmd := f.Update(keys('a'))
+	if value != "archive" || cmd == nil {
+		t.Errorf("Expected a to choose Archive and advance, got %q", value)
+	}
+}
+
+func TestMultiSelect(t *testing.T) {
+	field := NewMultiSelect[string]().Options(NewOptions("Foo", "Bar", "Baz")...).Title("Which one?")
+	f := NewForm(NewGroup(field))
+	f.Update(f.Init())
+
+	view := f.View()
+
+	if !strings.Contains(view, "Foo") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected field to contain Foo.")
+	}
+
+	if !strings.Contains(view, "Which one?") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected field to contain Which one?.")
+	}
+
+	if !strings.Contains(view, "> • Foo") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected cursor to be on Foo.")
+	}
+
+	// Move selection cursor down
+	m, _ := f.Update(keys('j'))
+	view = m.View()
+
+	if strings.Contains(view, "> • Foo") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected cursor to be on Bar.")
+	}
+
+	if !strings.Contains(view, "> • Bar") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected cursor to be on Bar.")
+	}
+
+	// Toggle
+	m, _ = f.Update(keys('x'))
+	view = m.View()
+
+	if !strings.Contains(view, "> ✓ Bar") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected cursor to be on Bar.")
+	}
+
+	if !strings.Contains(view, "x toggle • ↑ up • ↓ down • enter confirm • shift+tab back") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected field to contain help.")
+	}
+}
+
+func TestMultiSelectInitialValue(t *testing.T) {
+	value := []string{"Baz", "Foo", "Foo", "Qux"}
+	field := NewMultiSelect[string]().
+		Options(NewOptions("Foo", "Bar", "Baz")...).
+		Title("Which ones?").
+		Value(&value)
+	f := NewForm(NewGroup(field))
+	f.Update(f.Init())
+
+	view := f.View()
+
+	if !strings.Contains(view, "> ✓ Foo") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected Foo to be preselected.")
+	}
+
+	if !strings.Contains(view, "• Bar") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected Bar to not be selected.")
+	}
+
+	if !strings.Contains(view, "✓ Baz") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected Baz to be preselected.")
+	}
+
+	// Deselect Foo and select Bar.
+	m, _ := f.Update(keys('x'))
+	m, _ = m.Update(keys('j'))
+	m, _ = m.Update(keys('x'))
+	m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	if fmt.Sprint(value) != "[Bar Baz]" {
+		t.Errorf("Expected value to be [Bar Baz], got %v", value)
+	}
+}
+
+func TestInlineHistory(t *testing.T) {
+	f := NewForm(
+		NewGroup(
+			NewInput().Title("Name"),
+			NewConfirm().Title("Discount?"),
+		),
+		NewGroup(
+			NewSelect[string]().Title("Shell").Options(NewOptions("Soft", "Hard")...),
+		),
+	).WithInlineHistory(true)
+	f = batchUpdate(f, f.Init()).(*Form)
+
+	m := batchUpdate(f.Update(keys('G', 'l', 'e', 'n')))
+	m = batchUpdate(m.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+	view := m.View()
+
+	if !strings.Contains(view, "Name: Glen") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected answered input to collapse to a single line.")
+	}
+
+	if !strings.Contains(view, "Yes") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected focused confirm to be expanded.")
+	}
+
+	m = batchUpdate(m.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+	view = m.View()
+
+	if !strings.Contains(view, "Name: Glen") || !strings.Contains(view, "Discount?: No") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected previous group to collapse to single lines.")
+	}
+
+	if !strings.Contains(view, "> Soft") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected focused select to be expanded.")
+	}
+
+	// Fields are answered in tab order.
+	f = NewForm(NewGroup(
+		NewInput().Title("Name"),
+		NewInput().Title("Email").TabIndex(1),
+		NewInput().Title("Phone"),
+	)).WithInlineHistory(true)
+	f = batchUpdate(f, f.Init()).(*Form)
+	m = batchUpdate(f.Update(keys('j', '@', 'x')))
+	m = batchUpdate(m.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+	view = m.View()
+	if !strings.Contains(view, "Email: j@x") || strings.Contains(view, "Name:") || strings.Contains(view, "Phone:") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected the fields answered in tab order to collapse.")
+	}
+}
+
+func TestWarning(t *testing.T) {
+	f := NewForm(
+		NewGroup(
+			NewInput().Title("Name").Warn(func(s string) string {
+				if len(s) < 3 {
+					return "That's a short name."
+				}
+				return ""
+			}),
+			NewInput().Title("Email"),
+		),
+	)
+	f = batchUpdate(f, f.Init()).(*Form)
+
+	m := batchUpdate(f.Update(keys('B', 'o')))
+	m = batchUpdate(m.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+	view := m.View()
+
+	if !strings.Contains(view, "That's a short name.") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected warning to be rendered.")
+	}
+
+	if !strings.Contains(view, "┃ Email") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected warning not to block advancing to the next field.")
+	}
+
+	if len(m.(*Form).Warnings()) != 1 {
+		t.Error("Expected form to report one warning.")
+	}
+}
+
+func TestCursorMode(t *testing.T) {
+	input := NewInput().Title("Name")
+	text := NewText().Title("Bio").CursorMode(cursor.CursorHide)
+	f := NewForm(NewGroup(input, text)).WithCursorMode(cursor.CursorStatic)
+	f = batchUpdate(f, f.Init()).(*Form)
+
+	if input.textinput.Cursor.Mode() != cursor.CursorStatic {
+		t.Error("Expected input to use the form's default cursor mode.")
+	}
+
+	if text.textarea.Cursor.Mode() != cursor.CursorHide {
+		t.Error("Expected text to keep its own cursor mode.")
+	}
+
+	if input.textinput.Cursor.Blink {
+		t.Error("Expected focused input to show a static cursor.")
+	}
+
+	batchUpdate(f.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+
+	if !input.textinput.Cursor.Blink {
+		t.Error("Expected blurred input to hide its cursor.")
+	}
+}
+
+func TestIdleTimeout(t *testing.T) {
+	var name string
+	f := NewForm(NewGroup(NewInput().Key("name").Value(&name))).WithIdleTimeout(time.Minute, IdleSubmit)
+	f.Update(f.Init())
+	f.Update(keys('H', 'u', 'h'))
+
+	if view := f.View(); strings.Contains(view, "Submitting in") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected no countdown long before the idle timeout.")
+	}
+
+	if view := f.idleView(f.idleDeadline.Add(-3 * time.Second)); !strings.Contains(view, "Submitting in 3s") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected a countdown close to the idle timeout.")
+	}
+
+	f.Update(idleTickMsg(f.idleDeadline.Add(-time.Second)))
+	if f.State != StateNormal {
+		t.Error("Expected form to wait for the idle timeout.")
+	}
+
+	f.Update(idleTickMsg(f.idleDeadline))
+	if f.State != StateCompleted || name != "Huh" || f.GetString("name") != "Huh" {
+		t.Errorf("Expected idle form to be submitted with Huh, got state %d and %q", f.State, name)
+	}
+
+	f = NewForm(
+		NewGroup(NewInput().Key("name").Validate(func(s string) error {
+			if s == "" {
+				return errors.New("name is required")
+			}
+			return nil
+		})),
+		NewGroup(NewInput().Key("skipped")).WithHide(true),
+	).WithIdleTimeout(time.Minute, IdleSubmit)
+	f.Update(f.Init())
+	f.Update(idleTickMsg(f.idleDeadline))
+	if view := f.View(); f.State != StateNormal || !strings.Contains(view, "name is required") {
+		t.Log(pretty.Render(view))
+		t.Fatal("Expected an invalid idle form to stay open on its error.")
+	}
+	f.Update(keys('J', 'o'))
+	_, cmd := f.Update(idleTickMsg(f.idleDeadline))
+	if _, ok := f.Values()["skipped"]; f.State != StateCompleted || ok {
+		t.Errorf("Expected the idle form to be submitted without its hidden group, got state %d and %v", f.State, f.Values())
+	}
+	if !hasMsg(cmd, SubmitMsg{}) {
+		t.Error("Expected the idle submit to produce a SubmitMsg.")
+	}
+
+	f = NewForm(NewGroup(NewInput())).WithIdleTimeout(time.Minute, IdleAbort)
+	f.Update(f.Init())
+	_, cmd = f.Update(idleTickMsg(f.idleDeadline))
+	if f.State != StateAborted {
+		t.Error("Expected idle form to be aborted.")
+	}
+	if !hasMsg(cmd, AbortMsg{}) {
+		t.Error("Expected the idle abort to produce an AbortMsg.")
+	}
+}
+
+func TestIdleTimeoutBusy(t *testing.T) {
+	var country string
+	field := NewSelect[string]().Title("State").
+		OptionsFunc(func() []Option[string] { return NewOptions(country+"-1", country+"-2") }, &country).
+		OptionsDebounce(time.Hour)
+	f := NewForm(NewGroup(NewInput().Title("Country").Value(&country), field)).
+		WithIdleTimeout(time.Minute, IdleAbort)
+	f = batchUpdate(f, f.Init()).(*Form)
+	batchUpdate(f.Update(keys('u')))
+	batchUpdate(f.Update(keys('s')))
+
+	deadline := f.idleDeadline
+	f.Update(idleTickMsg(deadline))
+	if f.State != StateNormal || !f.idleDeadline.After(deadline) {
+		t.Fatalf("Expected the idle timeout to wait for the options to load, got state %d", f.State)
+	}
+
+	batchUpdate(f.Update(optionsDebounceMsg[string]{target: field, gen: 3}))
+	f.Update(idleTickMsg(f.idleDeadline))
+	if f.State != StateAborted {
+		t.Errorf("Expected the idle timeout once the options loaded, got state %d", f.State)
+	}
+}
+
+type fakeClipboard struct {
+	text string
+	err  error
+}
+
+func (c *fakeClipboard) WriteText(text string) error {
+	if c.err != nil {
+		return c.err
+	}
+	c.text = text
+	return nil
+}
+
+func TestCopy(t *testing.T) {
+	clipboard := &fakeClipboard{}
+	f := NewForm(NewGroup(NewNote().Title("Token").Description("s3cr3t"))).WithClipboard(clipboard)
+	f.Update(f.Init())
+
+	f.Update(tea.KeyMsg{Type: tea.KeyCtrlY})
+	if clipboard.text != "s3cr3t" || !strings.Contains(f.View(), "Copied!") {
+		t.Log(pretty.Render(f.View()))
+		t.Errorf("Expected the value to be copied with a confirmation, got %q", clipboard.text)
+	}
+
+	f.Update(clearCopiedMsg(f.copies))
+	if strings.Contains(f.View(), "Copied!") {
+		t.Log(pretty.Render(f.View()))
+		t.Error("Expected the confirmation to be cleared.")
+	}
+
+	// The confirmation of an earlier copy doesn't clear that of a later one,
+	// even if a key was pressed in between.
+	f.Update(tea.KeyMsg{Type: tea.KeyCtrlY})
+	earlier := f.copies
+	f.Update(tea.KeyMsg{Type: tea.KeyDown})
+	f.Update(tea.KeyMsg{Type: tea.KeyCtrlY})
+	f.Update(clearCopiedMsg(earlier))
+	if !strings.Contains(f.View(), "Copied!") {
+		t.Log(pretty.Render(f.View()))
+		t.Error("Expected the later confirmation to stay.")
+	}
+
+	clipboard.err = errors.New("no clipboard")
+	f.Update(tea.KeyMsg{Type: tea.KeyCtrlY})
+	if strings.Contains(f.View(), "Copied!") {
+		t.Log(pretty.Render(f.View()))
+		t.Error("Expected no confirmation without a clipboard.")
+	}
+}
+
+func TestEventHandler(t *testing.T) {
+	events := make(chan Event, eventBufferSize)
+	f := NewForm(
+		NewGroup(
+			NewInput().Key("name").Title("Name").Validate(func(s string) error {
+				if s == "" {
+					return fmt.Errorf("name is required")
+				}
+				return nil
+			}),
+			NewConfirm().Key("ok").Title("OK?"),
+		),
+	).WithEventHandler(func(e Event) { events <- e })
+	f = batchUpdate(f, f.Init()).(*Form)
+
+	m := batchUpdate(f.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+	m = batchUpdate(m.Update(keys('G')))
+	m = batchUpdate(m.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+	m = batchUpdate(m.Update(keys('h')))
+	batchUpdate(m.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+
+	expected := []struct {
+		Type EventType
+		Key  string
+	}{
+		{EventFocus, "name"},
+		{EventValidationError, "name"},
+		{EventValueChange, "name"},
+		{EventFocus, "ok"},
+		{EventValueChange, "ok"},
+		{EventSubmit, ""},
+	}
+
+	for _, want := range expected {
+		select {
+		case got := <-events:
+			if got.Type != want.Type || got.Key != want.Key {
+				t.Errorf("Expected %s event for %q, got %s event for %q", want.Type, want.Key, got.Type, got.Key)
+			}
+			if got.Time.IsZero() {
+				t.Error("Expected event to have a timestamp.")
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("Expected %s event for %q, got none", want.Type, want.Key)
+		}
+	}
+
+	select {
+	case <-f.eventsDone:
+	case <-time.After(time.Second):
+		t.Error("Expected the event handler's goroutine to end once the form was submitted.")
+	}
+}
+
+func TestWidthPercent(t *testing.T) {
+	input := NewInput().Title("Name")
+	text := NewText().Title("Bio")
+	f := NewForm(
+		NewGroup(input),
+		NewGroup(text).WithWidthPercent(50),
+	).WithWidthPercent(80)
+	f.Update(f.Init())
+
+	f.Update(tea.WindowSizeMsg{Width: 100})
+	if input.width != 80 || text.width != 40 {
+		t.Errorf("Expected widths of 80 and 40, got %d and %d", input.width, text.width)
+	}
+
+	f.Update(tea.WindowSizeMsg{Width: 200})
+	if input.width != 160 || text.width != 80 {
+		t.Errorf("Expected widths to be recomputed on resize, got %d and %d", input.width, text.width)
+	}
+
+	f.Update(tea.WindowSizeMsg{Width: 30})
+	if input.width != 24 || text.width != 20 {
+		t.Errorf("Expected widths to be clamped to a minimum, got %d and %d", input.width, text.width)
+	}
+}
+
+func TestAccessibleQuestions(t *testing.T) {
+	name, note, shell := NewInput(), NewNote(), NewSelect[string]()
+	fields := []Field{name, note, shell}
+
+	if n, total := questionNumber(fields, shell); n != 2 || total != 2 {
+		t.Errorf("Expected question 2 of 2, got %d of %d", n, total)
+	}
+
+	if n, _ := questionNumber(fields, note); n != 0 {
+		t.Errorf("Expected notes not to be numbered, got %d", n)
+	}
+
+	s := DefaultStrings()
+	if pos := previousQuestion(fields, 2, s); pos != 0 {
+		t.Errorf("Expected going back to skip notes, got %d", pos)
+	}
+
+	if pos := previousQuestion(fields, 0, s); pos != 0 {
+		t.Errorf("Expected going back from the first question to stay, got %d", pos)
+	}
+
+	if isBack(s, "back") {
+		t.Error("Expected back to be ignored outside of a form.")
+	}
+
+	s.allowBack = true
+	if !isBack(s, " Back ") {
+		t.Error("Expected back to be recognized in a form.")
+	}
+}
+
+type counter struct{ n int }
+
+func (c *counter) Init() tea.Cmd { return nil }
+func (c *counter) View() string  { return fmt.Sprintf("Count: %d", c.n) }
+func (c *counter) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok && msg.String() == "+" {
+		c.n++
+	}
+	return c, nil
+}
+
+func TestEmbed(t *testing.T) {
+	c := &counter{}
+	embed := NewEmbed(c, func() any { return c.n }).Key("count").Title("Counter")
+	f := NewForm(NewGroup(embed, NewInput().Title("Name")))
+	f = batchUpdate(f, f.Init()).(*Form)
+
+	m := batchUpdate(f.Update(keys('+')))
+	m = batchUpdate(m.Update(keys('+')))
+	view := m.View()
+
+	if !strings.Contains(view, "Counter") || !strings.Contains(view, "Count: 2") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected embedded model to receive keys and render.")
+	}
+
+	if !strings.Contains(view, "tab next") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected embed help to be rendered.")
+	}
+
+	m = batchUpdate(m.Update(tea.KeyMsg{Type: tea.KeyTab}))
+	m = batchUpdate(m.Update(keys('+')))
+
+	if embed.GetValue() != 2 {
+		t.Errorf("Expected value to be pulled from the model on blur, got %v", embed.GetValue())
+	}
+
+	if c.n != 2 {
+		t.Error("Expected blurred embedded model not to receive keys.")
+	}
+}
+
+func TestHelpToggle(t *testing.T) {
+	text := NewText().Title("Question")
+	f := NewForm(
+		NewGroup(text, NewSelect[string]().Options(NewOptions("Foo", "Bar")...).Title("Which one?")),
+	)
+	f = batchUpdate(f, f.Init()).(*Form)
+
+	m := batchUpdate(f.Update(keys('?')))
+	view := m.View()
+
+	if !strings.Contains(text.textarea.Value(), "?") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected ? to be typed into the text field.")
+	}
+
+	if strings.Contains(view, "? more") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected help binding to be hidden while entering text.")
+	}
+
+	m = batchUpdate(m.Update(tea.KeyMsg{Type: tea.KeyTab}))
+	view = m.View()
+
+	if !strings.Contains(view, "? more") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected help binding to be shown on a select field.")
+	}
+
+	m = batchUpdate(m.Update(keys('?')))
+	view = m.View()
+
+	if strings.Contains(view, "↑ up • ↓ down") || !strings.Contains(view, "↓ down") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected ? to toggle the full help.")
+	}
+}
+
+func TestSetTheme(t *testing.T) {
+	f := NewForm(NewGroup(NewInput().Title("Name"))).WithWidth(40)
+	f.Update(f.Init())
+
+	theme := ThemeBase().copy()
+	theme.Focused.Base = theme.Focused.Base.Copy().PaddingLeft(4)
+	theme.Focused.Title = theme.Focused.Title.Copy().SetString("New")
+	f.SetTheme(&theme)
+
+	view := f.View()
+	if !strings.Contains(view, "┃    New Name") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected the form to be rendered with the new theme.")
+	}
+}
+
+func TestGroupedHelp(t *testing.T) {
+	f := NewForm(NewGroup(NewSelect[string]().Options(NewOptions("Foo", "Bar")...).Title("Which one?"))).
+		WithGroupedHelp(true)
+	f.Update(f.Init())
+
+	view := f.View()
+	for _, want := range []string{"Navigation", "Editing", "Form", "/ filter", "ctrl+y copy"} {
+		if !strings.Contains(view, want) {
+			t.Log(pretty.Render(view))
+			t.Errorf("Expected grouped help to contain %q.", want)
+		}
+	}
+
+	f.Update(tea.WindowSizeMsg{Width: 30})
+	view = f.View()
+	if strings.Contains(view, "Navigation") || !strings.Contains(view, "↑ up") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected flat help at narrow widths.")
+	}
+}
+
+func TestKeepCompleted(t *testing.T) {
+	f := NewForm(NewGroup(NewInput().Title("Name"))).WithKeepCompleted(true)
+	f.Update(f.Init())
+	f.Update(keys('G', 'l', 'e', 'n'))
+	batchUpdate(f.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+
+	if f.State != StateCompleted {
+		t.Fatal("Expected the form to be completed.")
+	}
+	if view := f.View(); !strings.Contains(view, "Name: Glen") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected a summary of the answers to be kept.")
+	}
+}
+
+func TestLayoutMinimal(t *testing.T) {
+	f := NewForm(
+		NewGroup(
+			NewInput().Title("Name").Validate(func(s string) error {
+				if s == "" {
+					return fmt.Errorf("name is required")
+				}
+				return nil
+			}),
+			NewSelect[string]().Options(NewOptions("Foo", "Bar")...).Title("Which one?"),
+		),
+	).WithLayout(LayoutMinimal)
+	f = batchUpdate(f, f.Init()).(*Form)
+
+	view := f.View()
+
+	if !strings.HasPrefix(view, "Name") || strings.Contains(view, "┃") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected fields to be rendered without borders or padding.")
+	}
+
+	if strings.Contains(view, "enter") || strings.HasSuffix(view, "\n") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected no help or trailing space.")
+	}
+
+	m := batchUpdate(f.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+	view = m.View()
+
+	if !strings.HasSuffix(view, "name is required\n") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected errors to still be shown.")
+	}
+}
+
+func TestTabIndex(t *testing.T) {
+	a := NewInput().Title("A")
+	b := NewInput().Title("B").TabIndex(2)
+	c := NewInput().Title("C")
+	d := NewInput().Title("D").TabIndex(1)
+	f := NewForm(NewGroup(a, b, c, d))
+	f = batchUpdate(f, f.Init()).(*Form)
+
+	focused := func() string {
+		group := f.groups[0]
+		return fieldTitle(group.fields[group.paginator.Page])
+	}
+
+	var order []string
+	for i := 0; i < 4; i++ {
+		order = append(order, focused())
+		batchUpdate(f.Update(tea.KeyMsg{Type: tea.KeyTab}))
+		if f.State != StateNormal {
+			break
+		}
+	}
+
+	if got := strings.Join(order, ""); got != "DBAC" {
+		t.Errorf("Expected explicitly ordered fields first, then declaration order, got %s", got)
+	}
+}
+
+func TestTabIndexReverse(t *testing.T) {
+	a := NewInput().Title("A")
+	b := NewInput().Title("B").TabIndex(1)
+	c := NewInput().Title("C")
+	f := NewForm(NewGroup(a, b, c))
+	f = batchUpdate(f, f.Init()).(*Form)
+
+	batchUpdate(f.Update(tea.KeyMsg{Type: tea.KeyTab}))
+	batchUpdate(f.Update(tea.KeyMsg{Type: tea.KeyTab}))
+
+	var order []string
+	for i := 0; i < 3; i++ {
+		group := f.groups[0]
+		order = append(order, fieldTitle(group.fields[group.paginator.Page]))
+		batchUpdate(f.Update(tea.KeyMsg{Type: tea.KeyShiftTab}))
+	}
+
+	if got := strings.Join(order, ""); got != "CAB" {
+		t.Errorf("Expected shift+tab to reverse the tab order, got %s", got)
+	}
+}
+
+func TestFormValues(t *testing.T) {
+	f := NewForm(
+		NewGroup(
+			NewInput().Key("name").Title("Name"),
+			NewConfirm().Key("ok").Title("OK?"),
+		),
+		NewGroup(NewInput().Key("skipped").Title("Skipped")).WithHide(true),
+	)
+	f = batchUpdate(f, f.Init()).(*Form)
+
+	m := batchUpdate(f.Update(keys('G', 'l', 'e', 'n')))
+	m = batchUpdate(m.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+
+	if f.GetString("name") != "Glen" {
+		t.Errorf("Expected result to be saved when moving on, got %q", f.GetString("name"))
+	}
+
+	m = batchUpdate(m.Update(keys('h')))
+	batchUpdate(m.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+
+	values := f.Values()
+	if values["name"] != "Glen" || values["ok"] != true {
+		t.Errorf("Expected values by key, got %v", values)
+	}
+
+	if _, ok := values["skipped"]; ok {
+		t.Error("Expected fields of hidden groups to be left out.")
+	}
+}
+
+func TestAutosave(t *testing.T) {
+	var saved []map[string]any
+	newForm := func() *Form {
+		return NewForm(
+			NewGroup(NewInput().Key("name").Title("Name").Validate(func(s string) error {
+				if s == "" {
+					return errors.New("name is required")
+				}
+				return nil
+			})),
+			NewGroup(
+				NewSelect[int]().Key("size").Options(NewOptions(1, 2, 3)...).Title("Size"),
+				NewMultiSelect[string]().Key("toppings").Options(NewOptions("Cheese", "Ham")...).Title("Toppings"),
+			),
+		).WithAutosave(func(values map[string]any) {
+			saved = append(saved, values)
+		})
+	}
+
+	f := newForm()
+	f = batchUpdate(f, f.Init()).(*Form)
+	m := batchUpdate(f.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+	if len(saved) != 0 {
+		t.Fatal("Expected no autosave for a group that fails validation.")
+	}
+
+	m = batchUpdate(m.Update(keys('G', 'l', 'e', 'n')))
+	if len(saved) != 0 {
+		t.Fatal("Expected no autosave mid-edit.")
+	}
+	batchUpdate(m.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+	if len(saved) != 1 || saved[0]["name"] != "Glen" {
+		t.Fatalf("Expected the completed group to be saved, got %v", saved)
+	}
+	if _, ok := saved[0]["size"]; ok {
+		t.Error("Expected only completed groups to be saved.")
+	}
+
+	// Restore a draft as decoded from JSON.
+	f = newForm()
+	err := f.Load(map[string]any{"name": "Glen", "size": float64(2), "toppings": []any{"Ham"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f.GetString("name") != "Glen" || f.GetInt("size") != 2 {
+		t.Errorf("Expected values to be loaded, got %v", f.Values())
+	}
+	if toppings, ok := f.Get("toppings").([]string); !ok || len(toppings) != 1 || toppings[0] != "Ham" {
+		t.Errorf("Expected toppings to be loaded, got %v", f.Get("toppings"))
+	}
+
+	if err := f.Load(map[string]any{"size": "large"}); err == nil {
+		t.Error("Expected an error for a value of the wrong type.")
+	}
+}
+
+func TestChanges(t *testing.T) {
+	name, size := "Glen", 1
+	f := NewForm(
+		NewGroup(
+			NewInput().Key("name").Title("Name").Value(&name),
+			NewSelect[int]().Key("size").Options(NewOption("Small", 1), NewOption("Medium", 2)).Title("Size").Value(&size),
+		),
+	).WithInitialValues(map[string]any{"name": "Glen", "size": float64(1)})
+	f = batchUpdate(f, f.Init()).(*Form)
+
+	if changes := f.Changes(); len(changes) != 0 {
+		t.Errorf("Expected no changes, got %v", changes)
+	}
+
+	m := batchUpdate(f.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+	m = batchUpdate(m.Update(tea.KeyMsg{Type: tea.KeyDown}))
+	batchUpdate(m.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+
+	changes := f.Changes()
+	if len(changes) != 1 || changes["size"] != [2]any{float64(1), 2} {
+		t.Errorf("Expected the size to have changed, got %v", changes)
+	}
+
+	view := f.ChangesView()
+	if !strings.Contains(view, "Name: Glen") || !strings.Contains(view, "Size: 1 → 2") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected a summary of the changes, with both values formatted the same way.")
+	}
+}
+
+func TestGroupValidate(t *testing.T) {
+	var start, end string
+	f := NewForm(
+		NewGroup(
+			NewInput().Title("Start").Value(&start),
+			NewInput().Title("End").Value(&end),
+		).Validate(func() error {
+			s, err := time.Parse("2006-01-02", start)
+			if err != nil {
+				return err
+			}
+			e, err := time.Parse("2006-01-02", end)
+			if err != nil {
+				return err
+			}
+			if !e.After(s) {
+				return errors.New("end date must be after start date")
+			}
+			return nil
+		}),
+		NewGroup(NewNote().Title("Done")),
+	)
+	f = batchUpdate(f, f.Init()).(*Form)
+
+	m := batchUpdate(f.Update(keys([]rune("2024-05-02")...)))
+	m = batchUpdate(m.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+	m = batchUpdate(m.Update(keys([]rune("2024-05-01")...)))
+	m = batchUpdate(m.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+
+	view := f.View()
+	if f.paginator.Page != 0 || !strings.Contains(view, "end date must be after start date") {
+		t.Log(pretty.Render(view))
+		t.Fatal("Expected the group's error to keep the form from moving on.")
+	}
+	if f.groups[0].paginator.Page != 0 {
+		t.Error("Expected the first field of the group to be focused.")
+	}
+
+	m = batchUpdate(m.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+	m = batchUpdate(m.Update(tea.KeyMsg{Type: tea.KeyBackspace}))
+	m = batchUpdate(m.Update(keys('9')))
+	batchUpdate(m.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+
+	if f.paginator.Page != 1 || len(f.groups[0].Errors()) != 0 {
+		t.Log(pretty.Render(f.View()))
+		t.Error("Expected the form to move on once the group is valid.")
+	}
+}
+
+func TestPlaceholderStyle(t *testing.T) {
+	theme := ThemeCharm()
+	if theme.Focused.TextInput.Placeholder.GetItalic() {
+		t.Error("Expected the text input placeholder style to be left as it was.")
+	}
+	style := theme.Focused.placeholder()
+	if !style.GetItalic() || style.GetForeground() != theme.Focused.TextInput.Placeholder.GetForeground() {
+		t.Error("Expected placeholders to be italic, in the color of the text input placeholder style.")
+	}
+
+	theme.Focused.Placeholder = theme.Focused.Placeholder.Copy().Italic(false).Underline(true)
+	style = theme.Focused.placeholder()
+	if style.GetItalic() || !style.GetUnderline() {
+		t.Error("Expected the Placeholder style to take precedence.")
+	}
+}
+
+func TestSelectNoOptions(t *testing.T) {
+	field := NewSelect[string]().Title("Empty")
+	f := NewForm(NewGroup(field))
+	f = batchUpdate(f, f.Init()).(*Form)
+
+	m := batchUpdate(f.Update(tea.KeyMsg{Type: tea.KeyDown}))
+	batchUpdate(m.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+
+	if view := f.View(); !strings.Contains(view, "No options") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected a message in place of the options.")
+	}
+	if field.selected != 0 {
+		t.Errorf("Expected the cursor to stay put, got %d", field.selected)
+	}
+}
+
+func TestSecret(t *testing.T) {
+	var (
+		token string
+		saved map[string]any
+	)
+	events := make(chan Event, eventBufferSize)
+	f := NewForm(
+		NewGroup(
+			NewInput().Key("user").Title("User"),
+			NewInput().Key("token").Title("Token").Secret(true).Value(&token),
+		),
+	).WithInitialValues(map[string]any{"token": "old-secret"}).
+		WithAutosave(func(values map[string]any) { saved = values }).
+		WithEventHandler(func(e Event) { events <- e }).
+		WithKeepCompleted(true)
+	f = batchUpdate(f, f.Init()).(*Form)
+
+	m := batchUpdate(f.Update(keys('G', 'l', 'e', 'n')))
+	m = batchUpdate(m.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+	m = batchUpdate(m.Update(keys([]rune("s3cr3t")...)))
+	batchUpdate(m.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+
+	if token != "s3cr3t" || f.GetString("token") != "s3cr3t" {
+		t.Errorf("Expected the bound value to hold the secret, got %q", token)
+	}
+	if f.Values()["token"] != Redacted {
+		t.Errorf("Expected the secret to be redacted, got %v", f.Values()["token"])
+	}
+
+	values, _ := json.Marshal(f.Values())
+	outputs := []string{
+		string(values),
+		fmt.Sprint(saved),
+		fmt.Sprint(f.Changes()),
+		f.ChangesView(),
+		f.ToScript(),
+		f.View(),
+	}
+	for done := false; !done; {
+		select {
+		case e := <-events:
+			outputs = append(outputs, fmt.Sprint(e.Value))
+			done = e.Type == EventSubmit
+		case <-time.After(time.Second):
+			t.Fatal("Expected a submit event.")
+		}
+	}
+
+	for _, output := range outputs {
+		if strings.Contains(output, "s3cr3t") || strings.Contains(output, "old-secret") {
+			t.Log(output)
+			t.Error("Expected the secret to be redacted.")
+		}
+	}
+
+	if err := f.Load(saved); err != nil || token != "s3cr3t" {
+		t.Errorf("Expected loading a redacted secret to keep its value, got %q", token)
+	}
+}
+
+func TestMinHeight(t *testing.T) {
+	f := NewForm(
+		NewGroup(NewInput().Title("Name").Validate(func(s string) error {
+			if s == "" {
+				return errors.New("name is required")
+			}
+			return nil
+		})),
+	).WithMinHeight(8)
+	f = batchUpdate(f, f.Init()).(*Form)
+
+	before := lipgloss.Height(f.View())
+	batchUpdate(f.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+	view := f.View()
+
+	if !strings.Contains(view, "name is required") {
+		t.Fatal("Expected an error.")
+	}
+	if after := lipgloss.Height(view); before != 8 || after != 8 {
+		t.Log(pretty.Render(view))
+		t.Errorf("Expected the form to keep a height of 8, got %d then %d", before, after)
+	}
+}
+
+func TestInsertGroupOptions(t *testing.T) {
+	f := NewForm(NewGroup(NewInput().Title("First"))).
+		WithShowHelp(false).
+		WithShowErrors(false).
+		WithCompactHelp(true).
+		WithFocusedBorder(true).
+		WithValueFooter(true).
+		WithErrorPlacement(ErrorPlacementRight).
+		WithLayout(LayoutMinimal)
+	f.InsertGroup(1, NewGroup(NewInput().Title("Second")))
+
+	first, second := f.groups[0], f.groups[1]
+	if second.showHelp || second.showErrors || !second.compactHelp || !second.focusedBorder ||
+		!second.valueFooter || second.errorPlacement != ErrorPlacementRight || second.layout != LayoutMinimal {
+		t.Error("Expected the inserted group to get the options of the form.")
+	}
+	if first.showHelp != second.showHelp || first.layout != second.layout {
+		t.Error("Expected the inserted group to match the groups the form was made with.")
+	}
+
+	// Groups keep their own options where the form leaves the defaults.
+	f = NewForm(NewGroup(NewInput().Title("First")))
+	f.InsertGroup(1, NewGroup(NewInput().Title("Second")).WithShowHelp(false))
+	if f.groups[1].showHelp {
+		t.Error("Expected the inserted group to keep its own options.")
+	}
+
+	// A group inserted first before the form starts is where it starts.
+	f = NewForm(NewGroup(NewInput().Title("Second")))
+	f.InsertGroup(0, NewGroup(NewInput().Title("First")))
+	f = batchUpdate(f, f.Init()).(*Form)
+	if view := f.View(); !strings.Contains(view, "First") || strings.Contains(view, "Second") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected the form to start on the group inserted first.")
+	}
+}
+
+func TestInsertRemoveGroup(t *testing.T) {
+	var f *Form
+	var extra bool
+	f = NewForm(
+		NewGroup(NewConfirm().Title("Extra step?").Value(&extra)).Validate(func() error {
+			if extra {
+				f.InsertGroup(1, NewGroup(NewInput().Key("extra").Title("Extra")))
+			}
+			return nil
+		}),
+		NewGroup(NewInput().Key("last").Title("Last")),
+	)
+	f = batchUpdate(f, f.Init()).(*Form)
+
+	m := batchUpdate(f.Update(keys('h')))
+	m = batchUpdate(m.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+
+	if len(f.groups) != 3 || f.paginator.Page != 1 || f.paginator.TotalPages != 3 {
+		t.Fatalf("Expected to move on to the inserted group, on page %d of %d", f.paginator.Page, f.paginator.TotalPages)
+	}
+	if view := f.View(); !strings.Contains(view, "Extra") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected the inserted group to be shown.")
+	}
+
+	// Inserting before the current group keeps the current field.
+	m = batchUpdate(m.Update(keys('a')))
+	m = batchUpdate(m, f.InsertGroup(0, NewGroup(NewNote().Title("Intro"))))
+	m = batchUpdate(m.Update(keys('b')))
+	if f.paginator.Page != 2 || f.groups[2].fields[0].(*Input).textinput.Value() != "ab" {
+		t.Errorf("Expected the current field to keep focus, on page %d", f.paginator.Page)
+	}
+
+	// Removing the current group moves on to the next one.
+	f.RemoveGroup(2)
+	if len(f.groups) != 3 || f.paginator.Page != 2 {
+		t.Fatalf("Expected to move on to the next group, on page %d of %d", f.paginator.Page, len(f.groups))
+	}
+	batchUpdate(m.Update(keys('z')))
+	if view := f.View(); !strings.Contains(view, "Last") || !strings.Contains(view, "z") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected the next group to be shown.")
+	}
+
+	// Removing the current, last group moves back.
+	f.RemoveGroup(2)
+	if f.paginator.Page != 1 || f.paginator.TotalPages != 2 {
+		t.Errorf("Expected to move back to the previous group, on page %d of %d", f.paginator.Page, f.paginator.TotalPages)
+	}
+}
+
+func TestSelectValidateOption(t *testing.T) {
+	var order []string
+	field := NewSelect[int]().
+		Options(NewOption("Small", 1), NewOption("Large (sold out)", 2)).
+		Title("Size").
+		ValidateOption(func(o Option[int]) error {
+			order = append(order, "option")
+			if strings.Contains(o.Key, "sold out") {
+				return errors.New("sold out")
+			}
+			return nil
+		}).
+		Validate(func(int) error {
+			order = append(order, "value")
+			return nil
+		})
+	f := NewForm(NewGroup(field))
+	f = batchUpdate(f, f.Init()).(*Form)
+
+	m := batchUpdate(f.Update(tea.KeyMsg{Type: tea.KeyDown}))
+	m = batchUpdate(m.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+	if field.Error() == nil || strings.Join(order, ",") != "option" {
+		t.Fatalf("Expected the option to fail validation before the value, got %v", order)
+	}
+
+	order = nil
+	m = batchUpdate(m.Update(tea.KeyMsg{Type: tea.KeyUp}))
+	batchUpdate(m.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+	if field.Error() != nil || !strings.HasPrefix(strings.Join(order, ","), "option,value") {
+		t.Errorf("Expected the option then the value to be validated, got %v", order)
+	}
+
+	// Of options sharing a value, the one under the cursor is validated.
+	field = NewSelect[int]().
+		Options(NewOption("Large", 2), NewOption("Large (sold out)", 2)).
+		ValidateOption(func(o Option[int]) error {
+			if strings.Contains(o.Key, "sold out") {
+				return errors.New("sold out")
+			}
+			return nil
+		})
+	f = NewForm(NewGroup(field, NewInput()))
+	f = batchUpdate(f, f.Init()).(*Form)
+	m = batchUpdate(f.Update(tea.KeyMsg{Type: tea.KeyDown}))
+	batchUpdate(m.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+	if field.Error() == nil {
+		t.Error("Expected the option under the cursor to fail validation.")
+	}
+}
+
+func TestDescriptionVisibility(t *testing.T) {
+	f := NewForm(
+		NewGroup(
+			NewInput().Title("Name").Description("Your name"),
+			NewSelect[string]().Title("Size").Description("Pick a size").Options(NewOptions("S", "L")...),
+		),
+	).WithDescriptionVisibility(DescriptionOnFocus)
+	f = batchUpdate(f, f.Init()).(*Form)
+
+	view := f.View()
+	if !strings.Contains(view, "Your name") || strings.Contains(view, "Pick a size") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected only the description of the focused field.")
+	}
+
+	f.WithDescriptionVisibility(DescriptionNever)
+	if view := f.View(); strings.Contains(view, "Your name") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected no descriptions.")
+	}
+}
+
+func TestLink(t *testing.T) {
+	for _, env := range []string{"TMUX", "STY", "KITTY_WINDOW_ID", "WT_SESSION", "TERM_PROGRAM", "VTE_VERSION"} {
+		t.Setenv(env, "")
+	}
+	t.Setenv("TERM", "xterm-256color")
+
+	link := Link("docs", "https://charm.sh")
+	if link != "docs (https://charm.sh)" {
+		t.Errorf("Expected the URL after the text, got %q", link)
+	}
+
+	note := NewNote().Title("Help").Description("See the " + link)
+	f := NewForm(NewGroup(note))
+	f.Update(f.Init())
+	if view := f.View(); !strings.Contains(view, "https://charm.sh") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected the URL in the note.")
+	}
+
+	t.Setenv("TERM_PROGRAM", "WezTerm")
+	newForm := func(description string) *Form {
+		f := NewForm(NewGroup(NewNote().Title("Help").Description(description))).WithWidth(40)
+		f.Update(f.Init())
+		return f
+	}
+	open, closing := "\x1b]8;;https://charm.sh\x1b\\", "\x1b]8;;\x1b\\"
+	view := newForm("See the " + Link("docs", "https://charm.sh") + " for more").View()
+	if !strings.Contains(view, open+"docs"+closing) {
+		t.Errorf("Expected an OSC 8 hyperlink in the note, got %q", view)
+	}
+	plain := strings.NewReplacer(open, "", closing, "").Replace(view)
+	if want := newForm("See the docs for more").View(); plain != want {
+		t.Errorf("Expected the link to take the width of its text, got:\n%s\nwant:\n%s", plain, want)
+	}
+	if text := plainEscapes(Link("docs", "https://charm.sh")); text != "docs (https://charm.sh)" {
+		t.Errorf("Expected accessible output to write out the URL, got %q", text)
+	}
+
+	t.Setenv("TMUX", "/tmp/tmux-1000/default,1,0")
+	if link := Link("docs", "https://charm.sh"); strings.Contains(link, "\x1b") {
+		t.Errorf("Expected no hyperlink within tmux, got %q", link)
+	}
+}
+
+func TestSelectOptionsFunc(t *testing.T) {
+	var country string
+	calls := 0
+	field := NewSelect[string]().Title("State").
+		OptionsFunc(func() []Option[string] {
+			calls++
+			return NewOptions(country+"-1", country+"-2")
+		}, &country).
+		OptionsDebounce(time.Hour)
+	f := NewForm(NewGroup(NewInput().Title("Country").Value(&country), field))
+	f = batchUpdate(f, f.Init()).(*Form)
+
+	if calls != 1 || field.loading {
+		t.Fatalf("Expected the options to be computed once on init, got %d calls", calls)
+	}
+
+	m := batchUpdate(f.Update(keys('u')))
+	m = batchUpdate(m.Update(keys('s')))
+	if calls != 1 || !field.loading {
+		t.Fatalf("Expected the options to wait for input to settle, got %d calls", calls)
+	}
+
+	// The first keystroke's debounce is out of date by the time it is over.
+	m = batchUpdate(m.Update(optionsDebounceMsg[string]{target: field, gen: 2}))
+	if calls != 1 {
+		t.Errorf("Expected out of date recomputations to be dropped, got %d calls", calls)
+	}
+	batchUpdate(m.Update(optionsDebounceMsg[string]{target: field, gen: 3}))
+
+	if view := f.View(); calls != 2 || field.loading || !strings.Contains(view, "us-1") {
+		t.Log(pretty.Render(view))
+		t.Errorf("Expected the options to be recomputed once input settled, got %d calls", calls)
+	}
+}
+
+func TestButton(t *testing.T) {
+	newForm := func() *Form {
+		f := NewForm(NewGroup(
+			NewInput().Title("Name"),
+			NewButton().Add("Submit", ButtonSubmit).Add("Cancel", ButtonCancel),
+		))
+		return batchUpdate(f, f.Init()).(*Form)
+	}
+
+	f := newForm()
+	m := batchUpdate(f.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+	if f.State != StateNormal {
+		t.Fatal("Expected the form to wait for a button after the last question.")
+	}
+	if view := f.View(); !strings.Contains(view, "Submit") || !strings.Contains(view, "Cancel") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected the buttons to be shown.")
+	}
+	batchUpdate(m.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+	if f.State != StateCompleted {
+		t.Error("Expected the submit button to submit the form.")
+	}
+
+	f = newForm()
+	m = batchUpdate(f.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+	m = batchUpdate(m.Update(tea.KeyMsg{Type: tea.KeyRight}))
+	batchUpdate(m.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+	if f.State != StateAborted {
+		t.Error("Expected the cancel button to abort the form.")
+	}
+}
+
+func TestSelectValueFunc(t *testing.T) {
+	state := map[string]string{"size": "L"}
+	var sets []string
+	field := NewSelect[string]().Title("Size").Options(NewOptions("S", "M", "L")...).
+		ValueFunc(func() string { return state["size"] }, func(v string) {
+			sets = append(sets, v)
+			state["size"] = v
+		})
+	f := NewForm(NewGroup(field))
+	f = batchUpdate(f, f.Init()).(*Form)
+
+	if view := f.View(); !strings.Contains(view, "> L") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected the initial value to be selected.")
+	}
+
+	m := batchUpdate(f.Update(tea.KeyMsg{Type: tea.KeyUp}))
+	if len(sets) != 0 {
+		t.Error("Expected the setter not to be called while moving.")
+	}
+	batchUpdate(m.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+
+	if state["size"] != "M" || len(sets) != 1 {
+		t.Errorf("Expected the setter to be called once with M, got %v", sets)
+	}
+}
+
+func TestDescriptionHeight(t *testing.T) {
+	input := NewInput().Title("Name").Description("one\ntwo\nthree\nfour")
+	input.WithDescriptionHeight(2)
+	f := NewForm(NewGroup(input))
+	f = batchUpdate(f, f.Init()).(*Form)
+
+	view := f.View()
+	if !strings.Contains(view, "two ↓") || strings.Contains(view, "three") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected the first two lines of the description.")
+	}
+
+	f.Update(tea.KeyMsg{Type: tea.KeyCtrlDown})
+	f.Update(tea.KeyMsg{Type: tea.KeyCtrlDown})
+	f.Update(tea.KeyMsg{Type: tea.KeyCtrlDown})
+	view = f.View()
+	if !strings.Contains(view, "three ↑") || !strings.Contains(view, "four") || strings.Contains(view, "two") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected the description to scroll to its last lines.")
+	}
+
+	f.Update(tea.KeyMsg{Type: tea.KeyCtrlUp})
+	if view := f.View(); !strings.Contains(view, "two ↑") || !strings.Contains(view, "three ↓") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected the description to scroll back up.")
+	}
+}
+
+func TestSubmitKey(t *testing.T) {
+	var name string
+	f := NewForm(
+		NewGroup(NewInput().Title("Nickname")),
+		NewGroup(NewInput().Title("Name").Key("name").Value(&name).Validate(func(v string) error {
+			if v == "" {
+				return errors.New("name is required")
+			}
+			return nil
+		})),
+	)
+	keymap := NewDefaultKeyMap()
+	keymap.Submit.SetEnabled(true)
+	f.WithKeyMap(keymap)
+	f = batchUpdate(f, f.Init()).(*Form)
+
+	if view := f.View(); !strings.Contains(view, "ctrl+s submit") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected the submit key in the help.")
+	}
+
+	f.Update(tea.KeyMsg{Type: tea.KeyCtrlS})
+	view := f.View()
+	if f.State != StateNormal || !strings.Contains(view, "name is required") {
+		t.Log(pretty.Render(view))
+		t.Fatal("Expected to be taken to the error.")
+	}
+
+	f.Update(keys('J', 'o'))
+	f.Update(tea.KeyMsg{Type: tea.KeyCtrlS})
+	if f.State != StateCompleted || f.GetString("name") != "Jo" {
+		t.Errorf("Expected the form to be submitted, got state %v and name %q", f.State, f.GetString("name"))
+	}
+}
+
+func TestSubmitKeyMultiSelect(t *testing.T) {
+	f := NewForm(
+		NewGroup(NewInput().Title("Name")),
+		NewGroup(NewMultiSelect[string]().Title("Toppings").Options(NewOptions("Cheese", "Ham")...).Validate(func(v []string) error {
+			if len(v) == 0 {
+				return errors.New("pick a topping")
+			}
+			return nil
+		})),
+	)
+	keymap := NewDefaultKeyMap()
+	keymap.Submit.SetEnabled(true)
+	f.WithKeyMap(keymap)
+	f = batchUpdate(f, f.Init()).(*Form)
+
+	f.Update(tea.KeyMsg{Type: tea.KeyCtrlS})
+	view := f.View()
+	if f.State != StateNormal || !strings.Contains(view, "pick a topping") {
+		t.Log(pretty.Render(view))
+		t.Fatal("Expected to be taken to the multi-select's error.")
+	}
+
+	f.Update(keys(' '))
+	f.Update(tea.KeyMsg{Type: tea.KeyCtrlS})
+	if f.State != StateCompleted {
+		t.Errorf("Expected the form to be submitted, got state %v", f.State)
+	}
+}
+
+func TestSelectDropdown(t *testing.T) {
+	field := NewSelect[string]().Title("Size").Options(NewOptions("Small", "Medium", "Large")...).WithDropdown(true)
+	f := NewForm(NewGroup(field, NewInput().Title("Name")))
+	f = batchUpdate(f, f.Init()).(*Form)
+
+	view := f.View()
+	if !strings.Contains(view, "Small ▾") || strings.Contains(view, "Medium") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected a closed dropdown.")
+	}
+
+	f.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if view := f.View(); !strings.Contains(view, "Medium") || strings.Contains(view, "▾") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected the dropdown to open.")
+	}
+
+	f.Update(tea.KeyMsg{Type: tea.KeyDown})
+	f.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	view = f.View()
+	if !strings.Contains(view, "Medium ▾") || strings.Contains(view, "Large") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected the dropdown to close on the chosen option.")
+	}
+	if field.GetValue() != "Medium" || !field.focused {
+		t.Errorf("Expected Medium to be chosen without moving on, got %v", field.GetValue())
+	}
+}
+
+func TestInputHistory(t *testing.T) {
+	history := NewMemoryHistory("first", "second")
+	input := NewInput().Title("Command").WithHistory(history)
+	f := NewForm(NewGroup(input, NewInput().Title("Next")))
+	f = batchUpdate(f, f.Init()).(*Form)
+
+	f.Update(keys('t', 'h', 'i'))
+	f.Update(tea.KeyMsg{Type: tea.KeyUp})
+	f.Update(tea.KeyMsg{Type: tea.KeyUp})
+	if input.GetValue() != "first" {
+		t.Errorf("Expected to recall the oldest entry, got %q", input.GetValue())
+	}
+	f.Update(tea.KeyMsg{Type: tea.KeyUp})
+	if input.GetValue() != "first" {
+		t.Errorf("Expected to stay on the oldest entry, got %q", input.GetValue())
+	}
+
+	f.Update(tea.KeyMsg{Type: tea.KeyDown})
+	f.Update(tea.KeyMsg{Type: tea.KeyDown})
+	if input.GetValue() != "thi" {
+		t.Errorf("Expected to return to the typed value, got %q", input.GetValue())
+	}
+
+	f.Update(keys('r', 'd'))
+	f.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	entries, _ := history.Load()
+	if strings.Join(entries, ",") != "first,second,third" {
+		t.Errorf("Expected the submitted value to be saved, got %v", entries)
+	}
+}
+
+func TestSelectHiddenOptions(t *testing.T) {
+	hideBeta := false
+	value := "beta"
+	field := NewSelect[string]().Title("Pick").Value(&value).Options(
+		NewOption("Alpha", "alpha"),
+		NewOption("Beta", "beta").WithHideFunc(func() bool { return hideBeta }),
+		NewOption("Gamma", "gamma"),
+	)
+	f := NewForm(NewGroup(NewInput().Title("First"), field))
+	f = batchUpdate(f, f.Init()).(*Form)
+
+	hideBeta = true
+	f = batchUpdate(f, nextField).(*Form)
+	view := f.View()
+	if strings.Contains(view, "Beta") || value != "alpha" {
+		t.Log(pretty.Render(view))
+		t.Errorf("Expected Beta to be hidden and the value reset to the first option, got %q", value)
+	}
+
+	f.Update(tea.KeyMsg{Type: tea.KeyDown})
+	if view := f.View(); !strings.Contains(view, "> Gamma") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected to skip the hidden option.")
+	}
+}
+
+func TestTitleFunc(t *testing.T) {
+	var servers []string
+	calls := 0
+	f := NewForm(NewGroup(
+		NewMultiSelect[string]().Title("Servers").Value(&servers).Options(NewOptions("a", "b", "c")...),
+		NewInput().TitleFunc(func() string {
+			calls++
+			return fmt.Sprintf("Selected %d of 3 servers", len(servers))
+		}, &servers),
+	))
+	f = batchUpdate(f, f.Init()).(*Form)
+
+	if view := f.View(); !strings.Contains(view, "Selected 0 of 3 servers") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected the initial title.")
+	}
+
+	f.Update(tea.KeyMsg{Type: tea.KeySpace})
+	f.Update(tea.KeyMsg{Type: tea.KeyDown})
+	f.Update(tea.KeyMsg{Type: tea.KeySpace})
+	f = batchUpdate(f, func() tea.Msg { return tea.KeyMsg{Type: tea.KeyEnter} }).(*Form)
+	if view := f.View(); !strings.Contains(view, "Selected 2 of 3 servers") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected the title to follow the selection.")
+	}
+
+	f.View()
+	if calls != 2 {
+		t.Errorf("Expected the title to be computed once per change, got %d calls", calls)
+	}
+
+	// Maps changed in place are noticed.
+	counts := map[string]int{"a": 1}
+	f = NewForm(NewGroup(
+		NewInput().Title("Count"),
+		NewNote().TitleFunc(func() string { return fmt.Sprintf("%d of a", counts["a"]) }, &counts),
+	))
+	f = batchUpdate(f, f.Init()).(*Form)
+	counts["a"] = 2
+	f.Update(keys('x'))
+	if view := f.View(); !strings.Contains(view, "2 of") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected the title to follow a map changed in place.")
+	}
+}
+
+func TestTranscript(t *testing.T) {
+	name, token := "Jo", "hunter2"
+	nameField := NewInput().Title("Name").Value(&name)
+	tokenField := NewInput().Title("Token").Value(&token).Secret(true)
+	note := NewNote().Title("Welcome")
+
+	var transcript strings.Builder
+	f := NewForm(NewGroup(note, nameField, tokenField)).WithTranscript(&transcript)
+	for _, field := range []Field{note, nameField, tokenField} {
+		f.record(field)
+	}
+	f.flushTranscript()
+
+	if got := transcript.String(); got != "Name: Jo\nToken: "+Redacted+"\n" {
+		t.Errorf("Expected the answers with the secret redacted, got %q", got)
+	}
+}
+
+func TestSelectSortOptions(t *testing.T) {
+	value := "cherry"
+	field := NewSelect[string]().Title("Fruit").Value(&value).
+		Options(NewOptions("cherry", "apple", "Banana")...).
+		SortOptionsByKey()
+	f := NewForm(NewGroup(field))
+	f = batchUpdate(f, f.Init()).(*Form)
+
+	view := f.View()
+	apple, banana, cherry := strings.Index(view, "apple"), strings.Index(view, "Banana"), strings.Index(view, "cherry")
+	if apple < 0 || apple > banana || banana > cherry {
+		t.Log(pretty.Render(view))
+		t.Error("Expected the options sorted by key.")
+	}
+	if !strings.Contains(view, "> cherry") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected the cursor to stay on the chosen option.")
+	}
+}
+
+func TestFocusedBorder(t *testing.T) {
+	f := NewForm(NewGroup(
+		NewInput().Title("First"),
+		NewInput().Title("Second"),
+	)).WithWidth(40).WithFocusedBorder(true)
+	f = batchUpdate(f, f.Init()).(*Form)
+
+	view := f.View()
+	if strings.Count(view, "╭") != 1 || strings.Index(view, "╭") > strings.Index(view, "First") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected a border around the focused field only.")
+	}
+	if width := lipgloss.Width(view); width > 40 {
+		t.Log(pretty.Render(view))
+		t.Errorf("Expected the border to fit the width, got %d", width)
+	}
+
+	f.Update(nextField())
+	height := lipgloss.Height(view)
+	view = f.View()
+	if strings.Count(view, "╭") != 1 || strings.Index(view, "╭") < strings.Index(view, "First") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected the border to follow the focus.")
+	}
+	if lipgloss.Height(view) != height {
+		t.Error("Expected the form to keep its height as the focus moves.")
+	}
+}
+
+func TestNewSelectFunc(t *testing.T) {
+	type user struct {
+		ID   int
+		Name string
+	}
+	users := []user{{1, "Ada"}, {2, "Grace"}}
+	value := users[1]
+	field := NewSelectFunc(users, func(u user) string { return u.Name }).Title("User").Value(&value)
+	f := NewForm(NewGroup(field))
+	f = batchUpdate(f, f.Init()).(*Form)
+
+	if view := f.View(); !strings.Contains(view, "Ada") || !strings.Contains(view, "> Grace") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected the users keyed by name, with the cursor on the value.")
+	}
+}
+
+func TestRecover(t *testing.T) {
+	input := NewInput().Title("Name").Validate(func(string) error {
+		panic("boom")
+	})
+	f := NewForm(NewGroup(input, NewInput().Title("Next")))
+	f = batchUpdate(f, f.Init()).(*Form)
+
+	f.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if err := input.Error(); err == nil || !strings.Contains(err.Error(), "boom") {
+		t.Errorf("Expected the panic to become the field's error, got %v", err)
+	}
+
+	options := NewSelect[string]().Title("Pick").OptionsFunc(func() []Option[string] {
+		panic("no options")
+	}, nil)
+	f = NewForm(NewGroup(options))
+	f = batchUpdate(f, f.Init()).(*Form)
+	if err := options.Error(); err == nil || !strings.Contains(err.Error(), "no options") {
+		t.Errorf("Expected the panic of OptionsFunc to become the field's error, got %v", err)
+	}
+
+	f = NewForm(NewGroup(input)).WithRecover(false)
+	f = batchUpdate(f, f.Init()).(*Form)
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected the panic to go through without recovering.")
+		}
+	}()
+	f.Update(tea.KeyMsg{Type: tea.KeyEnter})
+}
+
+func TestSelectReset(t *testing.T) {
+	field := NewSelect[string]().Title("Size").Options(NewOptions("S", "M", "L")...).Default("M")
+	f := NewForm(NewGroup(field))
+	f = batchUpdate(f, f.Init()).(*Form)
+
+	f.Update(tea.KeyMsg{Type: tea.KeyDown})
+	if view := f.View(); !strings.Contains(view, "> L") {
+		t.Log(pretty.Render(view))
+		t.Fatal("Expected the cursor on L.")
+	}
+
+	f.Update(tea.KeyMsg{Type: tea.KeyCtrlR})
+	view := f.View()
+	if !strings.Contains(view, "> M") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected the cursor back on the default.")
+	}
+	if !strings.Contains(view, "ctrl+r reset") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected the reset binding in the help.")
+	}
+
+	field = NewSelect[string]().Title("Letter").Options(NewOptions("A", "B", "C", "D", "E", "F")...).Height(2)
+	f = NewForm(NewGroup(field))
+	f = batchUpdate(f, f.Init()).(*Form)
+	for i := 0; i < 5; i++ {
+		f.Update(tea.KeyMsg{Type: tea.KeyDown})
+	}
+	field.reset()
+	if field.offset != 0 {
+		t.Errorf("Expected the options to scroll back to the first option, got an offset of %d", field.offset)
+	}
+}
+
+func TestRunBatchCollect(t *testing.T) {
+	required := func(v string) error {
+		if v == "" {
+			return errors.New("required")
+		}
+		return nil
+	}
+	newForm := func() *Form {
+		return NewForm(NewGroup(
+			NewInput().Key("name").Title("Name").Validate(required),
+			NewInput().Key("email").Title("Email").Validate(required),
+			NewConfirm().Key("agree").Title("Agree"),
+			NewMultiSelect[string]().Key("tags").Title("Tags").Options(NewOptions("a", "b")...).Validate(func(v []string) error {
+				if len(v) == 0 {
+					return errors.New("required")
+				}
+				return nil
+			}),
+		))
+	}
+
+	f := newForm()
+	_, errs := f.RunBatchCollect(map[string]any{"agree": "maybe"})
+	if len(errs) != 4 || errs["name"] == nil || errs["email"] == nil || errs["agree"] == nil || errs["tags"] == nil {
+		t.Errorf("Expected every failure, got %v", errs)
+	}
+	if f.State == StateCompleted {
+		t.Error("Expected the form not to be completed.")
+	}
+
+	f = newForm()
+	values, errs := f.RunBatchCollect(map[string]any{"name": "Jo", "email": "jo@example.com", "agree": true, "tags": []string{"b"}})
+	if errs != nil || values["name"] != "Jo" || values["agree"] != true || f.State != StateCompleted {
+		t.Errorf("Expected the form to complete with the answers, got %v and %v", values, errs)
+	}
+}
+
+func TestSelectFilterMessages(t *testing.T) {
+	field := NewSelect[string]().Title("Fruit").Options(NewOptions("apple", "banana", "cherry")...)
+	f := NewForm(NewGroup(field))
+	f = batchUpdate(f, f.Init()).(*Form)
+
+	f.Update(keys('/'))
+	f.Update(keys('a', 'p'))
+	if view := f.View(); !strings.Contains(view, "showing 1 of 3") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected the count of matching options.")
+	}
+
+	f.Update(keys('z'))
+	if view := f.View(); !strings.Contains(view, "No matches for 'apz'") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected the no matches message.")
+	}
+
+	f = batchUpdate(f, func() tea.Msg { return tea.KeyMsg{Type: tea.KeyEnter} }).(*Form)
+	if f.State != StateNormal || field.GetValue() != "" {
+		t.Errorf("Expected nothing to be chosen, got %q", field.GetValue())
+	}
+}
+
+func TestAccessibleWidth(t *testing.T) {
+	view := accessibleBlock(lipgloss.NewStyle().PaddingLeft(2), "Which of these options would you like to choose today?", 24)
+	lines := strings.Split(view, "\n")
+	if len(lines) < 3 {
+		t.Errorf("Expected the text to wrap, got %q", view)
+	}
+	for _, line := range lines {
+		if lipgloss.Width(line) > 24 || strings.HasSuffix(line, " ") {
+			t.Errorf("Expected lines within the width without padding, got %q", line)
+		}
+	}
+
+	if width := accessibleWidth(0); width <= 0 {
+		t.Errorf("Expected a default width, got %d", width)
+	}
+}
+
+func TestMultiSelectToggleAll(t *testing.T) {
+	var picked []string
+	field := NewMultiSelect[string]().Options(NewOptions("Foo", "Bar", "Baz")...).Limit(2).Value(&picked)
+	f := NewForm(NewGroup(field))
+	f = batchUpdate(f, f.Init()).(*Form)
+
+	f.Update(tea.KeyMsg{Type: tea.KeyCtrlA})
+	view := f.View()
+	if !strings.Contains(view, "✓ Foo") || !strings.Contains(view, "✓ Bar") || strings.Contains(view, "✓ Baz") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected the first two options to be selected.")
+	}
+
+	f.Update(tea.KeyMsg{Type: tea.KeyCtrlA})
+	if view := f.View(); strings.Contains(view, "✓") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected the selection to be cleared at the limit.")
+	}
+
+	field = NewMultiSelect[string]().Options(NewOptions("Foo", "Bar", "Baz")...).Value(&picked)
+	f = NewForm(NewGroup(field))
+	f = batchUpdate(f, f.Init()).(*Form)
+	f.Update(tea.KeyMsg{Type: tea.KeyCtrlA})
+	view = f.View()
+	if !strings.Contains(view, "✓ Foo") || !strings.Contains(view, "✓ Bar") || !strings.Contains(view, "✓ Baz") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected every option to be selected.")
+	}
+	if !strings.Contains(view, "ctrl+a toggle all") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected the toggle all binding in the help.")
+	}
+}
+
+func TestErrorPlacement(t *testing.T) {
+	newForm := func(placement ErrorPlacement, percent int) *Form {
+		f := NewForm(
+			NewGroup(
+				NewInput().Title("Name").Validate(func(s string) error {
+					if s == "" {
+						return fmt.Errorf("name is required")
+					}
+					return nil
+				}),
+				NewInput().Title("Email"),
+			).WithWidthPercent(percent),
+		).WithWidth(60).WithErrorPlacement(placement)
+		f = batchUpdate(f, f.Init()).(*Form)
+		return batchUpdate(f.Update(tea.KeyMsg{Type: tea.KeyEnter})).(*Form)
+	}
+
+	for _, percent := range []int{50, 0} {
+		view := newForm(ErrorPlacementRight, percent).View()
+		var beside bool
+		for _, line := range strings.Split(view, "\n") {
+			if strings.Contains(line, "Name") && strings.Contains(line, "name is required") {
+				beside = true
+			}
+			if w := lipgloss.Width(line); w > 60 {
+				t.Errorf("Expected the error within the width, got a line of %d", w)
+			}
+		}
+		if !beside || strings.Count(view, "name is required") != 1 {
+			t.Log(pretty.Render(view))
+			t.Errorf("Expected the error to the right of the field only, at %d%%.", percent)
+		}
+	}
+
+	view := newForm(ErrorPlacementTooltip, 50).View()
+	if !strings.Contains(view, "╭") || strings.Count(view, "name is required") != 1 {
+		t.Log(pretty.Render(view))
+		t.Error("Expected the error in a box below the field.")
+	}
+	if strings.Index(view, "name is required") > strings.Index(view, "Email") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected the error before the next field.")
+	}
+}
+
+func TestDefaultFromEnv(t *testing.T) {
+	t.Setenv("HUH_TEST_NAME", "Glenn")
+	t.Setenv("HUH_TEST_SIZE", "L")
+	t.Setenv("HUH_TEST_EMPTY", "")
+
+	name, other := "Static", "Static"
+	var size string
+	f := NewForm(NewGroup(
+		NewInput().Title("Name").DefaultFromEnv("HUH_TEST_NAME").Value(&name),
+		NewInput().Title("Other").Value(&other).DefaultFromEnv("HUH_TEST_EMPTY"),
+		NewSelect[string]().Title("Size").Options(NewOptions("S", "M", "L")...).Default("M").DefaultFromEnv("HUH_TEST_SIZE").Value(&size),
+	))
+	f = batchUpdate(f, f.Init()).(*Form)
+
+	if name != "Glenn" {
+		t.Errorf("Expected the environment to take precedence, got %q", name)
+	}
+	if other != "Static" {
+		t.Errorf("Expected an empty variable to keep the value, got %q", other)
+	}
+
+	f.NextField()
+	f.NextField()
+	view := f.View()
+	if !strings.Contains(view, "> L (default)") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected the cursor on the option from the environment.")
+	}
+}
+
+func TestSelectConfirmMessage(t *testing.T) {
+	var action string
+	field := NewSelect[string]().Title("Action").Value(&action).Options(
+		NewOption("Keep", "keep"),
+		NewOption("Delete", "delete").ConfirmMessage("Delete everything?"),
+	)
+	f := NewForm(NewGroup(field), NewGroup(NewInput().Title("Next")))
+	f = batchUpdate(f, f.Init()).(*Form)
+
+	f.Update(tea.KeyMsg{Type: tea.KeyDown})
+	f.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	view := f.View()
+	if !strings.Contains(view, "Delete everything? [y/N]") || !strings.Contains(view, "y yes • n no") {
+		t.Log(pretty.Render(view))
+		t.Fatal("Expected the confirmation to be asked.")
+	}
+
+	f.Update(keys('n'))
+	view = f.View()
+	if strings.Contains(view, "Delete everything?") || !strings.Contains(view, "> Delete") || action != "" {
+		t.Log(pretty.Render(view))
+		t.Fatal("Expected declining to return to the list.")
+	}
+
+	f.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	batchUpdate(f.Update(keys('y')))
+	if action != "delete" {
+		t.Errorf("Expected delete to be chosen, got %q", action)
+	}
+	if view := f.View(); strings.Contains(view, "Action") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected the form to move on after accepting.")
+	}
+
+	// Going back or pressing the accelerator doesn't choose it unconfirmed.
+	action = ""
+	field = NewSelect[string]().Title("Action").Value(&action).Options(
+		NewOption("Keep", "keep"),
+		NewOption("Delete", "delete").ConfirmMessage("Delete everything?").Accelerator('d'),
+	)
+	field.Focus()
+	field.Update(tea.KeyMsg{Type: tea.KeyDown})
+	field.Update(tea.KeyMsg{Type: tea.KeyShiftTab})
+	field.Update(keys('d'))
+	if action != "" {
+		t.Errorf("Expected the option not to be chosen without confirmation, got %q", action)
+	}
+
+	// New options drop a pending confirmation.
+	for _, options := range [][]Option[string]{NewOptions("keep", "other"), nil} {
+		field = NewSelect[string]().Title("Action").Value(&action).Options(
+			NewOption("Keep", "keep"),
+			NewOption("Delete", "delete").ConfirmMessage("Delete everything?"),
+		)
+		field.Focus()
+		field.Update(tea.KeyMsg{Type: tea.KeyDown})
+		field.Update(tea.KeyMsg{Type: tea.KeyEnter})
+		field.Update(optionsMsg[string]{target: field, gen: field.optionsGen, options: options})
+		field.Update(keys('y'))
+		if view := field.View(); action != "" || strings.Contains(view, "Delete everything?") {
+			t.Log(pretty.Render(view))
+			t.Errorf("Expected new options to drop the confirmation, got %q", action)
+		}
+	}
+}
+
+func TestInputGraphemes(t *testing.T) {
+	newInput := func(value string) (*Form, *Input, *string) {
+		input := NewInput().Title("Name").Value(&value)
+		f := NewForm(NewGroup(input))
+		f = batchUpdate(f, f.Init()).(*Form)
+		return f, input, &value
+	}
+
+	family := "\U0001F468\u200D\U0001F469\u200D\U0001F467"
+	f, input, value := newInput("a" + family + "b")
+	f.Update(tea.KeyMsg{Type: tea.KeyLeft})
+	f.Update(tea.KeyMsg{Type: tea.KeyLeft})
+	if pos := input.textinput.Position(); pos != 1 {
+		t.Errorf("Expected the cursor to step over the family emoji, got %d", pos)
+	}
+	f.Update(tea.KeyMsg{Type: tea.KeyRight})
+	if pos := input.textinput.Position(); pos != 6 {
+		t.Errorf("Expected the cursor after the family emoji, got %d", pos)
+	}
+	f.Update(tea.KeyMsg{Type: tea.KeyLeft})
+	f.Update(tea.KeyMsg{Type: tea.KeyDelete})
+	if *value != "ab" {
+		t.Errorf("Expected the family emoji to be deleted whole, got %q", *value)
+	}
+
+	flag, accent := "\U0001F1F3\U0001F1F1", "e\u0301"
+	f, input, value = newInput("x" + flag + accent)
+	f.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+	if *value != "x"+flag {
+		t.Errorf("Expected the accented character to be deleted whole, got %q", *value)
+	}
+	f.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+	if *value != "x" || input.textinput.Position() != 1 {
+		t.Errorf("Expected the flag to be deleted whole, got %q", *value)
+	}
+}
+
+func TestValueFooter(t *testing.T) {
+	class := "Mage"
+	var empty string
+	f := NewForm(NewGroup(
+		NewSelect[string]().Title("Class").Options(NewOptions("Warrior", "Mage", "Rogue")...).Value(&class),
+		NewSelect[string]().Title("Race").Options(NewOptions("Elf", "Dwarf")...).Value(&empty),
+	)).WithValueFooter(true)
+	f = batchUpdate(f, f.Init()).(*Form)
+
+	f.Update(tea.KeyMsg{Type: tea.KeyUp})
+	view := f.View()
+	if !strings.Contains(view, "> Warrior") || !strings.Contains(view, "Current: Mage") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected the footer to show the committed value while browsing.")
+	}
+
+	batchUpdate(f.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+	if view := f.View(); strings.Contains(view, "Current:") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected no footer for a field without a value.")
+	}
+
+	toppings := []string{"Cheese", "Ham"}
+	f = NewForm(NewGroup(
+		NewMultiSelect[string]().Title("Toppings").Options(NewOptions("Cheese", "Olives", "Ham")...).Value(&toppings),
+	)).WithValueFooter(true)
+	f = batchUpdate(f, f.Init()).(*Form)
+	if view := f.View(); !strings.Contains(view, "Current: Cheese, Ham") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected the footer to list the checked options.")
+	}
+}
+
+func TestInputAllowedRunes(t *testing.T) {
+	var host string
+	field := NewInput().Title("Host").Value(&host).AllowedRunes(AllowHostname).Transform(strings.ToLower)
+	f := NewForm(NewGroup(field))
+	f = batchUpdate(f, f.Init()).(*Form)
+
+	f.Update(keys('E', 'x', '_', 'a'))
+	f.Update(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}})
+	f.Update(keys('!', '.', 'i', 'o'))
+	if host != "exa.io" {
+		t.Errorf("Expected disallowed characters to be dropped, got %q", host)
+	}
+
+	if !AllowAlphanumeric('é') || AllowHostname('é') || AllowDigits('a') {
+		t.Error("Expected the presets to allow their character sets only.")
+	}
+}
+
+func TestSelectBorderedList(t *testing.T) {
+	field := NewSelect[string]().Title("Menu").Options(NewOptions("Start", "Options", "Quit")...).WithBorderedList(true)
+	f := NewForm(NewGroup(field)).WithWidth(30)
+	f = batchUpdate(f, f.Init()).(*Form)
+
+	view := f.View()
+	lines := strings.Split(view, "\n")
+	if !strings.Contains(lines[1], "╭") || !strings.Contains(view, "│> Start") || !strings.Contains(view, "╰") {
+		t.Log(pretty.Render(view))
+		t.Fatal("Expected the options in a box below the title.")
+	}
+	for _, line := range lines[1:6] {
+		if w := lipgloss.Width(strings.TrimRight(line, " ")); w != 30 {
+			t.Log(pretty.Render(view))
+			t.Fatalf("Expected the box to span the width, got a line of %d cells.", w)
+		}
+	}
+}
+
+func TestAccessibleErrors(t *testing.T) {
+	s := DefaultStrings()
+	err := errors.New("name is required")
+	if text := s.reprompt(err); text != "Error: name is required" {
+		t.Errorf("Expected the error to be marked, got %q", text)
+	}
+
+	s.question = "Name"
+	s.bell = true
+	if text := s.reprompt(err); text != "\aError: name is required\nName" {
+		t.Errorf("Expected the bell and the question to be repeated, got %q", text)
+	}
+
+	s.ErrorPrefix = "Erreur : "
+	if text := s.errorText(err); text != "\aErreur : name is required" {
+		t.Errorf("Expected a custom error marker, got %q", text)
+	}
+}
+
+func TestSelectMultilineOptions(t *testing.T) {
+	field := NewSelect[string]().Title("Class").Height(2).Options(
+		NewOption("Warrior\nFights up close", "warrior"),
+		NewOption("Rogue", "rogue"),
+		NewOption("Mage\nCasts spells\nFrom afar", "mage"),
+	)
+	f := NewForm(NewGroup(field))
+	f = batchUpdate(f, f.Init()).(*Form)
+
+	view := field.View()
+	if !strings.Contains(view, "> Warrior") || !strings.Contains(view, "\n┃   Fights up close") {
+		t.Log(pretty.Render(view))
+		t.Fatal("Expected the lines of an option to be aligned after the selector.")
+	}
+	height := lipgloss.Height(view)
+
+	f.Update(tea.KeyMsg{Type: tea.KeyDown})
+	f.Update(tea.KeyMsg{Type: tea.KeyDown})
+	view = field.View()
+	if !strings.Contains(view, "> Mage") || !strings.Contains(view, "From afar") || strings.Contains(view, "Warrior") {
+		t.Log(pretty.Render(view))
+		t.Fatal("Expected the window to scroll by option.")
+	}
+	if lipgloss.Height(view) != height {
+		t.Log(pretty.Render(view))
+		t.Errorf("Expected the field to keep its height of %d lines, got %d", height, lipgloss.Height(view))
+	}
+}
+
+func TestAccessibleQuit(t *testing.T) {
+	s := DefaultStrings()
+	if isQuit(s, "quit") {
+		t.Error("Expected quit to be ignored outside of a form.")
+	}
+
+	s.allowBack = true
+	expectPrompts(t, "Input: ", " Quit ")
+	if _, err := promptString(s, s.Input, func(string) error { return nil }); !errors.Is(err, errQuit) {
+		t.Errorf("Expected quitting to be recognized in a form, got %v", err)
+	}
+
+	f := NewForm(NewGroup(NewInput()))
+	if !f.quitConfirmed(s, errQuit) {
+		t.Error("Expected quitting not to be confirmed by default.")
+	}
+
+	f.WithAccessibleAbortConfirm(true)
+	expectPrompts(t, s.ConfirmQuit, "n", s.ConfirmQuit, "y")
+	if f.quitConfirmed(s, errQuit) {
+		t.Error("Expected declining to keep the form running.")
+	}
+	if !f.quitConfirmed(s, errQuit) {
+		t.Error("Expected accepting to quit.")
+	}
+}
+
+func TestAccessibleInterrupt(t *testing.T) {
+	var name string
+	f := NewForm(NewGroup(NewInput().Value(&name))).
+		WithAccessible(true).
+		WithAccessibleAbortConfirm(true)
+
+	expectPrompts(t,
+		"Input: ", interruptAnswer,
+		"Are you sure you want to quit? [y/N]: ", "n",
+		"Input: ", "Frodo",
+		"Submit? [y/N]: ", "y",
+	)
+	if err := f.Run(); err != nil || name != "Frodo" {
+		t.Fatalf("Expected declining to ask the question again, got %q and %v", name, err)
+	}
+
+	f = NewForm(NewGroup(NewInput())).
+		WithAccessible(true).
+		WithAccessibleAbortConfirm(true)
+	expectPrompts(t,
+		"Input: ", interruptAnswer,
+		"Are you sure you want to quit? [y/N]: ", "y",
+	)
+	if err := f.Run(); !errors.Is(err, ErrUserAborted) {
+		t.Errorf("Expected confirming to abort the form, got %v", err)
+	}
+}
+
+func TestRunFormSecret(t *testing.T) {
+	input, typing, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer input.Close()
+	defer typing.Close()
+	programOptions = []tea.ProgramOption{tea.WithInput(input), tea.WithOutput(io.Discard)}
+	defer func() { programOptions = nil }()
+
+	_, _ = typing.WriteString("s3cr3t\r")
+	values, err := RunForm(NewGroup(NewInput().Key("token").Title("Token").Secret(true)))
+	if err != nil || values["token"] != "s3cr3t" {
+		t.Errorf("Expected RunForm to return the typed secret, got %v and %v", values["token"], err)
+	}
+}
+
+func TestMultiSelectValueSet(t *testing.T) {
+	set := map[string]struct{}{"Ham": {}, "Cheese": {}}
+	var toppings []string
+	field := ValueSet(NewMultiSelect[string]().Options(NewOptions("Cheese", "Olives", "Ham")...).Value(&toppings), &set)
+	f := NewForm(NewGroup(field))
+	f = batchUpdate(f, f.Init()).(*Form)
+
+	view := f.View()
+	if !strings.Contains(view, "✓ Cheese") || !strings.Contains(view, "• Olives") || !strings.Contains(view, "✓ Ham") {
+		t.Log(pretty.Render(view))
+		t.Fatal("Expected the options in the set to be checked.")
+	}
+
+	f.Update(keys('j'))
+	f.Update(keys('x'))
+	f.Update(keys('j'))
+	f.Update(keys('x'))
+	batchUpdate(f.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+
+	if _, ok := set["Olives"]; !ok || len(set) != 2 {
+		t.Errorf("Expected the set to hold the checked options, got %v", set)
+	}
+	if fmt.Sprint(toppings) != "[Cheese Olives]" {
+		t.Errorf("Expected the slice in the order of the options, got %v", toppings)
+	}
+}
+
+func TestValidationMode(t *testing.T) {
+	newForm := func(mode ValidationMode) *Form {
+		f := NewForm(NewGroup(
+			NewInput().Title("Name").Validate(func(s string) error {
+				if len(s) < 3 {
+					return errors.New("name is too short")
+				}
+				return nil
+			}),
+			NewInput().Title("Email"),
+		)).WithValidationMode(mode)
+		return batchUpdate(f, f.Init()).(*Form)
+	}
+	focused := func(f *Form) string {
+		group := f.groups[0]
+		return fieldTitle(group.fields[group.paginator.Page])
+	}
+
+	// On blur, the field keeps the focus until it's valid.
+	f := newForm(ValidationOnBlur)
+	f.Update(keys('a'))
+	if view := f.View(); strings.Contains(view, "name is too short") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected no error while typing.")
+	}
+	batchUpdate(f.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+	if view := f.View(); !strings.Contains(view, "name is too short") || focused(f) != "Name" {
+		t.Log(pretty.Render(view))
+		t.Error("Expected the error on blur.")
+	}
+
+	// On submit, the user moves on and is brought back on submit.
+	f = newForm(ValidationOnSubmit)
+	f.Update(keys('a'))
+	batchUpdate(f.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+	if view := f.View(); strings.Contains(view, "name is too short") || focused(f) != "Email" {
+		t.Log(pretty.Render(view))
+		t.Fatal("Expected validation to be deferred.")
+	}
+	batchUpdate(f.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+	if view := f.View(); !strings.Contains(view, "name is too short") || focused(f) != "Name" || f.State != StateNormal {
+		t.Log(pretty.Render(view))
+		t.Fatal("Expected submitting to jump to the first error.")
+	}
+	f.Update(keys('b', 'c'))
+	batchUpdate(f.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+	batchUpdate(f.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+	if f.State != StateCompleted {
+		t.Error("Expected the form to complete once valid.")
+	}
+
+	// Inline, errors show while typing.
+	f = newForm(ValidationInline)
+	f.Update(keys('a'))
+	if view := f.View(); !strings.Contains(view, "name is too short") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected the error while typing.")
+	}
+	f.Update(keys('b', 'c'))
+	if view := f.View(); strings.Contains(view, "name is too short") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected the error to clear once valid.")
+	}
+
+	// Multi-selects are validated on submit too.
+	f = NewForm(NewGroup(
+		NewMultiSelect[string]().Title("Toppings").Options(NewOptions("Cheese", "Ham")...).Validate(func(v []string) error {
+			if len(v) == 0 {
+				return errors.New("pick a topping")
+			}
+			return nil
+		}),
+	)).WithValidationMode(ValidationOnSubmit)
+	f = batchUpdate(f, f.Init()).(*Form)
+	batchUpdate(f.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+	if view := f.View(); !strings.Contains(view, "pick a topping") || f.State != StateNormal {
+		t.Log(pretty.Render(view))
+		t.Fatal("Expected submitting to validate the multi-select.")
+	}
+	f.Update(keys(' '))
+	batchUpdate(f.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+	if f.State != StateCompleted {
+		t.Error("Expected the form to complete once the multi-select is valid.")
+	}
+}
+
+func TestSelectCollapsibleGroups(t *testing.T) {
+	var food string
+	field := NewSelect[string]().Title("Food").Value(&food).Options(
+		NewOption("Water", "water"),
+		NewOption("Apple", "apple").Group("Fruits"),
+		NewOption("Carrot", "carrot").Group("Vegetables"),
+		NewOption("Banana", "banana").Group("Fruits"),
+	).WithCollapsibleGroups(true)
+	f := NewForm(NewGroup(field), NewGroup(NewInput().Title("Next")))
+	f = batchUpdate(f, f.Init()).(*Form)
+
+	view := f.View()
+	if !strings.Contains(view, "> Water") || !strings.Contains(view, "▾ Fruits") || strings.Index(view, "Banana") > strings.Index(view, "Vegetables") {
+		t.Log(pretty.Render(view))
+		t.Fatal("Expected the options under the headers of their groups.")
+	}
+
+	f.Update(tea.KeyMsg{Type: tea.KeyDown})
+	f.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	view = f.View()
+	if !strings.Contains(view, "> ▸ Fruits (2)") || strings.Contains(view, "Apple") {
+		t.Log(pretty.Render(view))
+		t.Fatal("Expected Next on a header to collapse its group.")
+	}
+
+	f.Update(tea.KeyMsg{Type: tea.KeyDown})
+	f.Update(tea.KeyMsg{Type: tea.KeyDown})
+	f.Update(tea.KeyMsg{Type: tea.KeyLeft})
+	if view := f.View(); !strings.Contains(view, "> ▸ Vegetables (1)") {
+		t.Log(pretty.Render(view))
+		t.Fatal("Expected Left to collapse the group under the cursor.")
+	}
+
+	f.Update(tea.KeyMsg{Type: tea.KeyRight})
+	f.Update(tea.KeyMsg{Type: tea.KeyDown})
+	batchUpdate(f.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+	if food != "carrot" {
+		t.Errorf("Expected carrot to be chosen, got %q", food)
+	}
+}
+
+func TestSelectOptionRenderer(t *testing.T) {
+	var widths []int
+	var field *Select[int]
+	field = NewSelect[int]().Title("Plan").Options(
+		NewOption("Free", 0),
+		NewOption("Pro", 10),
+	).WithOptionRenderer(func(option Option[int], selected, focused bool) string {
+		widths = append(widths, field.SelectorWidth())
+		row := fmt.Sprintf("%-6s $%d", option.Key, option.Value)
+		if selected && focused {
+			row += " [current]"
+		}
+		return row
+	})
+	f := NewForm(NewGroup(field))
+	f = batchUpdate(f, f.Init()).(*Form)
+
+	view := f.View()
+	if !strings.Contains(view, "> Free   $0 [current]") || !strings.Contains(view, "  Pro    $10") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected the rows to be rendered by the renderer after the selector.")
+	}
+	if len(widths) == 0 || widths[0] != 2 {
+		t.Errorf("Expected the selector width to be queryable, got %v", widths)
+	}
+}
+
+func TestSubmitAndAbortMsgs(t *testing.T) {
+	f := NewForm(NewGroup(NewInput().Title("Name")))
+	f.Update(f.Init())
+
+	f.Update(keys('J', 'o'))
+	_, cmd := f.Update(submitFormMsg{})
+	if f.State != StateCompleted {
+		t.Fatal("Expected the form to be completed.")
+	}
+	if !hasMsg(cmd, SubmitMsg{}) {
+		t.Error("Expected the command to produce a SubmitMsg.")
+	}
+
+	f = NewForm(NewGroup(NewInput().Title("Name")))
+	f.Update(f.Init())
+	_, cmd = f.Update(tea.KeyMsg{Type: tea.KeyCtrlC})
+	if f.State != StateAborted {
+		t.Fatal("Expected the form to be aborted.")
+	}
+	if !hasMsg(cmd, AbortMsg{}) {
+		t.Error("Expected the command to produce an AbortMsg.")
+	}
+}
+
+// hasMsg reports whether cmd, or a command it batches, produces want.
+func hasMsg(cmd tea.Cmd, want tea.Msg) bool {
+	if cmd == nil {
+		return false
+	}
+	msg := runCmd(cmd)
+	if batch, ok := msg.(tea.BatchMsg); ok {
+		for _, c := range batch {
+			if hasMsg(c, want) {
+				return true
+			}
+		}
+		return false
+	}
+	return msg == want
+}
+
+func TestRequiredSummary(t *testing.T) {
+	required := func() bool { return true }
+	f := NewForm(NewGroup(
+		NewInput().Title("Name"),
+		NewInput().Title("City").RequiredIf(required),
+		NewInput().Title("Email").RequiredIf(required),
+	)).WithRequiredSummary(true)
+	f = batchUpdate(f, f.Init()).(*Form)
+
+	f.Update(keys('C', 'h', 'a', 'r', 'm'))
+	f = batchUpdate(f, func() tea.Msg { return submitFormMsg{} }).(*Form)
+	if f.State != StateNormal {
+		t.Fatal("Expected the form not to complete with empty required fields.")
+	}
+
+	focused := fieldTitle(f.groups[0].fields[f.groups[0].paginator.Page])
+	if focused != "City" {
+		t.Errorf("Expected the first empty required field to be focused, got %q", focused)
+	}
+	view := f.View()
+	if !strings.Contains(view, "Fill in the required fields:") ||
+		!strings.Contains(view, "• City") || !strings.Contains(view, "• Email") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected the empty required fields to be listed.")
+	}
+
+	f.Update(keys('O', 's', 'l', 'o'))
+	view = f.View()
+	if strings.Contains(view, "• City") || !strings.Contains(view, "• Email") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected the list to drop the field that was filled in.")
+	}
+}
+
+func TestKeyRemap(t *testing.T) {
+	if conflicts := NewDefaultKeyMap().Conflicts(); len(conflicts) > 0 {
+		t.Errorf("Expected no conflicts in the default keymap, got %v", conflicts)
+	}
+
+	keymap := NewDefaultKeyMap().Remap("/", "ctrl+f")
+	field := NewSelect[string]().Title("Path").Options(
+		NewOption("/usr", "/usr"),
+		NewOption("/etc", "/etc"),
+		NewOption("home", "home"),
+	)
+	f := NewForm(NewGroup(field)).WithKeyMap(keymap)
+	f = batchUpdate(f, f.Init()).(*Form)
+	if view := f.View(); !strings.Contains(view, "ctrl+f filter") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected the help to show the remapped key.")
+	}
+
+	f.Update(keys('/'))
+	if field.filtering {
+		t.Error("Expected / to no longer start filtering.")
+	}
+	f.Update(tea.KeyMsg{Type: tea.KeyCtrlF})
+	f.Update(keys('/', 'e', 'j'))
+	if !field.filtering || field.filter.Value() != "/ej" {
+		t.Errorf("Expected the filter to keep typed text, got %q", field.filter.Value())
+	}
+
+	keymap = NewDefaultKeyMap()
+	keymap.Input.Next.SetKeys("enter", ";")
+	keymap.Quit.SetKeys("ctrl+c", "q")
+	conflicts := keymap.Conflicts()
+	if len(conflicts) != 2 {
+		t.Fatalf("Expected the plain characters to be reported, got %v", conflicts)
+	}
+
+	input := NewInput().Title("Name").RemapKey("enter", "ctrl+n")
+	f = NewForm(NewGroup(input, NewInput().Title("City"))).WithKeyMap(keymap)
+	f = batchUpdate(f, f.Init()).(*Form)
+	f = batchUpdate(f.Update(keys('q', ';'))).(*Form)
+	if f.State != StateNormal || input.textinput.Value() != "q;" {
+		t.Errorf("Expected the input to keep typed text, got %q", input.textinput.Value())
+	}
+	f = batchUpdate(f.Update(tea.KeyMsg{Type: tea.KeyEnter})).(*Form)
+	if title := fieldTitle(f.groups[0].fields[f.groups[0].paginator.Page]); title != "Name" {
+		t.Error("Expected enter to be remapped on the input field.")
+	}
+	f = batchUpdate(f.Update(tea.KeyMsg{Type: tea.KeyCtrlN})).(*Form)
+	if title := fieldTitle(f.groups[0].fields[f.groups[0].paginator.Page]); title != "City" {
+		t.Error("Expected the remapped key to move to the next field.")
+	}
+}
+
+func TestFormViewWithoutRunning(t *testing.T) {
+	f := NewForm(
+		NewGroup(NewInput().Title("Skipped")).WithHideFunc(func() bool { return true }),
+		NewGroup(
+			NewInput().Title("Name"),
+			NewSelect[string]().Title("Size").Options(NewOptions("S", "M")...),
+		),
+	)
+
+	if f.View(); f.initialized {
+		t.Error("Expected View to leave the form as it is.")
+	}
+	view := f.Render()
+	if !strings.Contains(view, "┃ Name") || strings.Contains(view, "┃ Size") || strings.Contains(view, "Skipped") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected the first visible group to be rendered with its first field focused.")
+	}
+	if view != f.Render() || view != f.View() {
+		t.Error("Expected the view to be stable.")
+	}
+}
+
+func TestFieldsStandalone(t *testing.T) {
+	fields := []Field{
+		NewInput().Title("Name"),
+		NewText().Title("Bio"),
+		NewSelect[string]().Title("Size").Options(NewOptions("S", "M")...),
+		NewMultiSelect[string]().Title("Toppings").Options(NewOptions("Cheese", "Olives")...),
+		NewTree[string]().Title("Path").Options(NewOptions("usr", "etc")...),
+		NewConfirm().Title("Sure?"),
+		NewNote().Title("Note"),
+		NewButton().Title("Done"),
+		NewEmbed(NewInput(), nil).Title("Embedded"),
+		NewSlider().Title("Volume"),
+	}
+
+	for _, field := range fields {
+		field.Init()
+		field.Focus()
+		field.Update(tea.KeyMsg{Type: tea.KeyDown})
+		if view := field.View(); !strings.Contains(view, fieldTitle(field)) {
+			t.Log(pretty.Render(view))
+			t.Errorf("Expected %q to render without a form.", fieldTitle(field))
+		}
+		if len(field.KeyBinds()) == 0 {
+			t.Errorf("Expected %q to have the default keymap.", fieldTitle(field))
+		}
+	}
+}
+
+func TestSlider(t *testing.T) {
+	size := 4.0
+	field := NewSlider().Title("Memory").Range(0.5, 8).Step(0.5).Unit("GB").Value(&size)
+	f := NewForm(NewGroup(field))
+	f = batchUpdate(f, f.Init()).(*Form)
+
+	f.Update(tea.KeyMsg{Type: tea.KeyRight})
+	if size != 4.5 {
+		t.Errorf("Expected right to step up by 0.5, got %v", size)
+	}
+	view := f.View()
+	if !strings.Contains(view, "4.5 GB") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected the value to be shown with its unit.")
+	}
+
+	f.Update(tea.KeyMsg{Type: tea.KeyShiftLeft})
+	if size != 3.5 {
+		t.Errorf("Expected shift+left to step down by a tenth of the range, got %v", size)
+	}
+	for i := 0; i < 20; i++ {
+		f.Update(tea.KeyMsg{Type: tea.KeyLeft})
+	}
+	if size != 0.5 {
+		t.Errorf("Expected the value to stop at the minimum, got %v", size)
+	}
+	view = f.View()
+	if !strings.Contains(view, "●─────") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected the thumb at the start of the track.")
+	}
+
+	size = 12
+	field.Blur()
+	if field.Error() == nil || field.Error().Error() != "must be between 0.5 GB and 8 GB" {
+		t.Errorf("Expected the value to be out of range, got %v", field.Error())
+	}
+	if field.DisplayValue() != "12 GB" {
+		t.Errorf("Expected the display value to have the unit, got %q", field.DisplayValue())
+	}
+
+	temp := 40.0
+	f = NewForm(NewGroup(NewSlider().Title("Temperature").Range(-100, 30).Value(&temp))).WithWidth(40)
+	f = batchUpdate(f, f.Init()).(*Form)
+	if temp != 30 {
+		t.Errorf("Expected a bound value out of range to start at the nearest end, got %v", temp)
+	}
+	temp = -100
+	for _, line := range strings.Split(f.View(), "\n") {
+		if strings.Contains(line, "●") && lipgloss.Width(line) > 40 {
+			t.Errorf("Expected the track to leave room for the widest value, got %q", line)
+		}
+	}
+
+	size = 0
+	field = NewSlider().Title("Memory").Range(0.5, 8).Step(0.5).Value(&size).WithAccessible(true).(*Slider)
+	expectPrompts(t, "Enter a number from 0.5 to 8: ", "2.7")
+	if err := field.Run(); err != nil {
+		t.Fatal(err)
+	}
+	if size != 2.5 {
+		t.Errorf("Expected the accessible answer to snap to a step, got %v", size)
+	}
+}
+
+func TestThemeErrorStyles(t *testing.T) {
+	theme := ThemeCharm()
+	theme.Error.Title = theme.Error.Title.Copy().SetString("✗")
+
+	field := NewInput().Title("Name").Validate(func(v string) error {
+		if v == "" {
+			return errors.New("name is required")
+		}
+		return nil
+	})
+	f := NewForm(NewGroup(field, NewInput().Title("City"))).WithTheme(theme)
+	f = batchUpdate(f, f.Init()).(*Form)
+
+	if view := f.View(); !strings.Contains(view, "┃ Name") {
+		t.Log(pretty.Render(view))
+		t.Fatal("Expected the focused styles before an error.")
+	}
+	f.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if view := f.View(); !strings.Contains(view, "┃ ✗ Name *") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected the error styles once the field has an error.")
+	}
+
+	theme.Error = FieldStyles{}
+	if view := f.View(); !strings.Contains(view, "┃ Name *") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected the focused styles for a theme without error styles.")
+	}
+
+	for name, theme := range map[string]*Theme{
+		"base":       ThemeBase(),
+		"charm":      ThemeCharm(),
+		"dracula":    ThemeDracula(),
+		"base16":     ThemeBase16(),
+		"catppuccin": ThemeCatppuccin(),
+	} {
+		if !theme.Disabled.Title.GetFaint() || theme.Error.Title.GetForeground() == (lipgloss.NoColor{}) {
+			t.Errorf("Expected the %s theme to have error and disabled styles.", name)
+		}
+	}
+}
+
+func TestCompactHelp(t *testing.T) {
+	f := NewForm(NewGroup(
+		NewSelect[string]().Title("Size").Options(NewOptions("S", "M", "L")...),
+	)).WithCompactHelp(true).WithWidth(30)
+	f = batchUpdate(f, f.Init()).(*Form)
+
+	view := f.View()
+	lines := strings.Split(strings.TrimRight(view, "\n"), "\n")
+	legend := strings.TrimSpace(lines[len(lines)-1])
+	if !strings.HasPrefix(legend, "↑ up • ↓ down") || !strings.HasSuffix(legend, "…") {
+		t.Log(pretty.Render(view))
+		t.Errorf("Expected a single line legend cut short with an ellipsis, got %q", legend)
+	}
+	if lipgloss.Width(legend) > 30 {
+		t.Errorf("Expected the legend to fit the width, got %d", lipgloss.Width(legend))
+	}
+
+	f = NewForm(NewGroup(NewInput().Title("Name"))).WithCompactHelp(true)
+	f = batchUpdate(f, f.Init()).(*Form)
+	if view := f.View(); !strings.Contains(view, "enter next • shift+tab back") || strings.Contains(view, "? more") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected the whole legend without the help toggle.")
+	}
+
+	keymap := NewDefaultKeyMap()
+	keymap.Quit.SetHelp("ctrl+c", "quit")
+	f = NewForm(NewGroup(NewConfirm().Title("Sure?"))).
+		WithCompactHelp(true).WithFieldSearch(true).WithKeyMap(keymap)
+	f = batchUpdate(f, f.Init()).(*Form)
+	if view := f.View(); !strings.Contains(view, "/ search fields") || !strings.Contains(view, "ctrl+c quit") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected the legend to end with the form's bindings.")
+	}
+}
+
+func TestRunGroup(t *testing.T) {
+	var name, city string
+	f := NewForm(
+		NewGroup(NewInput().Title("Name").Value(&name)),
+		NewGroup(NewInput().Title("City").Value(&city)),
+	).WithAccessible(true)
+
+	if err := f.RunGroup(2); err == nil {
+		t.Error("Expected an error for a group that doesn't exist.")
+	}
+
+	expectPrompts(t, "Input: ", "Oslo", "Submit? [y/N]: ", "y")
+	if err := f.RunGroup(1); err != nil {
+		t.Fatal(err)
+	}
+	if city != "Oslo" || name != "" {
+		t.Errorf("Expected only the second group to run, got %q and %q", name, city)
+	}
+	if len(f.groups) != 2 || f.State != StateCompleted {
+		t.Error("Expected the form to keep its groups and complete the run.")
+	}
+
+	expectPrompts(t, "Input: ", "Ada", "Submit? [y/N]: ", "y")
+	if err := f.RunGroup(0); err != nil {
+		t.Fatal(err)
+	}
+	if name != "Ada" || city != "Oslo" {
+		t.Errorf("Expected the bound values to carry over, got %q and %q", name, city)
+	}
+}
+
+// interruptAnswer is an answer to expectPrompts that interrupts the test
+// instead, as ctrl+c does in a terminal.
+const interruptAnswer = "^C"
+
+// expectPrompts answers accessible prompts, given as pairs of a prompt and
+// its answer, writing each answer once its prompt is printed.
+func expectPrompts(t *testing.T, pairs ...string) {
+	t.Helper()
+	inR, inW, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	outR, outW, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	stdin, stdout := os.Stdin, os.Stdout
+	os.Stdin, os.Stdout = inR, outW
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		var printed strings.Builder
+		buf := make([]byte, 1024)
+		for len(pairs) > 0 {
+			n, err := outR.Read(buf)
+			if err != nil {
+				return
+			}
+			printed.Write(buf[:n])
+			for len(pairs) > 0 && strings.Contains(printed.String(), pairs[0]) {
+				rest := printed.String()[strings.Index(printed.String(), pairs[0])+len(pairs[0]):]
+				printed.Reset()
+				printed.WriteString(rest)
+				if pairs[1] == interruptAnswer {
+					p, _ := os.FindProcess(os.Getpid())
+					_ = p.Signal(os.Interrupt)
+				} else {
+					_, _ = inW.WriteString(pairs[1] + "\n")
+				}
+				pairs = pairs[2:]
+			}
+		}
+		_, _ = io.Copy(io.Discard, outR)
+	}()
+
+	t.Cleanup(func() {
+		os.Stdin, os.Stdout = stdin, stdout
+		_ = outW.Close()
+		<-done
+		_ = inW.Close()
+		_ = inR.Close()
+		_ = outR.Close()
+	})
+}
+
+func TestFormPadding(t *testing.T) {
+	f := NewForm(NewGroup(NewInput().Title("Name"))).
+		WithPadding(1, 2, 1, 4).
+		WithWidth(40).
+		WithMinHeight(10)
+	f = batchUpdate(f, f.Init()).(*Form)
+
+	if width := f.groups[0].width; width != 34 {
+		t.Errorf("Expected the groups to get the width inside the padding, got %d", width)
+	}
+	view := f.View()
+	lines := strings.Split(view, "\n")
+	if strings.TrimSpace(lines[0]) != "" || !strings.HasPrefix(lines[1], "    ┃ Name") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected the form to be padded.")
+	}
+	if lipgloss.Height(view) != 10 || lipgloss.Width(view) != 40 {
+		t.Errorf("Expected the padding within the size of the form, got %dx%d", lipgloss.Width(view), lipgloss.Height(view))
+	}
+
+	theme := ThemeCharm()
+	theme.Form = theme.Form.Copy().PaddingLeft(3)
+	f = NewForm(NewGroup(NewInput().Title("Name"))).WithTheme(theme)
+	f = batchUpdate(f, f.Init()).(*Form)
+	if view := f.View(); !strings.HasPrefix(view, "   ┃ Name") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected the theme's form padding by default.")
+	}
+}
+
+func TestSelectOptionStatus(t *testing.T) {
+	field := NewSelect[string]().Title("Region").
+		Options(NewOptions("us-east", "eu-west", "ap-south")...).
+		WithOptionStatusFunc(func(option Option[string]) tea.Cmd {
+			switch option.Value {
+			case "eu-west":
+				return func() tea.Msg { return errors.New("offline") }
+			case "ap-south":
+				return func() tea.Msg { return OptionStatusMsg{Available: true} }
+			}
+			return nil
+		})
+	f := NewForm(NewGroup(field))
+	// The checks are left running, but for the one of eu-west.
+	f.Init()
+	f.Update(optionStatusMsg[string]{
+		target: field,
+		gen:    field.statusGen,
+		index:  1,
+		status: optionStatus{checked: true, OptionStatusMsg: OptionStatusMsg{Reason: "offline"}},
+	})
+
+	view := f.View()
+	if !strings.Contains(view, "eu-west offline") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected the unavailable option to show its reason.")
+	}
+	if strings.Contains(view, "ap-south\n") || !strings.Contains(view, "ap-south ") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected a spinner next to the option being checked.")
+	}
+
+	f.Update(tea.KeyMsg{Type: tea.KeyDown})
+	if field.selected != 0 {
+		t.Errorf("Expected navigation to skip unchecked and unavailable options, got %d", field.selected)
+	}
+
+	batchUpdate(f, field.checkOptions())
+	if view := f.View(); !strings.Contains(view, "eu-west offline") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected the error of a check to become the option's reason.")
+	}
+	f.Update(tea.KeyMsg{Type: tea.KeyDown})
+	if field.selected != 2 {
+		t.Errorf("Expected navigation to reach the option once available, got %d", field.selected)
+	}
+	batchUpdate(f.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+	if f.State != StateCompleted || field.GetValue() != "ap-south" {
+		t.Errorf("Expected the available option to be chosen, got %v", field.GetValue())
+	}
+}
+
+func TestSelectOptionStatusSameKey(t *testing.T) {
+	field := NewSelect[int]().Title("Mirror").
+		Options(NewOption("mirror", 1), NewOption("mirror", 2)).
+		WithOptionStatusFunc(func(option Option[int]) tea.Cmd {
+			if option.Value == 1 {
+				return func() tea.Msg { return errors.New("offline") }
+			}
+			return nil
+		})
+	f := NewForm(NewGroup(field))
+	f = batchUpdate(f, f.Init()).(*Form)
+
+	if field.available(0) || !field.available(1) {
+		t.Error("Expected options with the same key to be checked apart.")
+	}
+	batchUpdate(f.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+	if f.State != StateCompleted || field.GetValue() != 2 {
+		t.Errorf("Expected the available option to be chosen, got %v", field.GetValue())
+	}
+}
+
+func TestFieldSearch(t *testing.T) {
+	var name string
+	f := NewForm(
+		NewGroup(
+			NewInput().Title("Name").Value(&name).Validate(func(s string) error {
+				if s == "" {
+					return errors.New("name is required")
+				}
+				return nil
+			}),
+			NewInput().Title("Email"),
+		),
+		NewGroup(
+			NewInput().Title("City"),
+			NewConfirm().Title("Subscribe"),
+		).Title("Newsletter"),
+	).WithFieldSearch(true)
+	f = batchUpdate(f, f.Init()).(*Form)
+	focused := func() string {
+		group := f.groups[f.paginator.Page]
+		return fieldTitle(group.fields[group.paginator.Page])
+	}
+
+	// Typing / in an input is text, ctrl+f searches from anywhere.
+	f.Update(keys('/'))
+	if f.searching || f.groups[0].fields[0].(*Input).textinput.Value() != "/" {
+		t.Fatal("Expected / to be typed into the input.")
+	}
+	f.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+	f.Update(tea.KeyMsg{Type: tea.KeyCtrlF})
+	view := f.View()
+	if !strings.Contains(view, "Search fields") || !strings.Contains(view, "Subscribe Newsletter") {
+		t.Log(pretty.Render(view))
+		t.Fatal("Expected the field search to list the fields.")
+	}
+
+	f.Update(keys('s', 'u', 'b'))
+	view = f.View()
+	if strings.Contains(view, "Email") || !strings.Contains(view, "> Subscribe") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected the search to filter the titles of the fields.")
+	}
+
+	// Jumping ahead past an invalid group focuses its error instead.
+	batchUpdate(f.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+	if f.searching || focused() != "Name" || !strings.Contains(f.View(), "name is required") {
+		t.Log(pretty.Render(f.View()))
+		t.Fatalf("Expected the error of the group passed over to be focused, got %q", focused())
+	}
+
+	// So does jumping ahead within the group.
+	f.Update(tea.KeyMsg{Type: tea.KeyCtrlF})
+	f.Update(keys('e', 'm'))
+	batchUpdate(f.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+	if focused() != "Name" || !strings.Contains(f.View(), "name is required") {
+		t.Log(pretty.Render(f.View()))
+		t.Fatalf("Expected the error of the field passed over to be focused, got %q", focused())
+	}
+
+	f.Update(keys('S', 'a', 'm'))
+	f.Update(tea.KeyMsg{Type: tea.KeyCtrlF})
+	f.Update(keys('s', 'u', 'b'))
+	batchUpdate(f.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+	if f.paginator.Page != 1 || focused() != "Subscribe" {
+		t.Fatalf("Expected the chosen field to be focused, got %q", focused())
+	}
+
+	// Away from text entry / opens the search too, and esc closes it.
+	f.Update(keys('/'))
+	f.Update(tea.KeyMsg{Type: tea.KeyDown})
+	f.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if f.searching || focused() != "Subscribe" {
+		t.Errorf("Expected esc to close the search, got %q", focused())
+	}
+}
+
+func TestFieldSearchAccessible(t *testing.T) {
+	var name, city string
+	f := NewForm(NewGroup(
+		NewInput().Title("Name").Value(&name),
+		NewInput().Title("City").Value(&city),
+	)).WithAccessible(true).WithFieldSearch(true)
+
+	expectPrompts(t,
+		"Input: ", "goto 2",
+		"Input: ", "Oslo",
+		"Submit? [y/N]: ", "goto 1",
+		"Input: ", "Ada",
+		"Input: ", "Oslo",
+		"Submit? [y/N]: ", "y",
+	)
+	if err := f.Run(); err != nil {
+		t.Fatal(err)
+	}
+	if name != "Ada" || city != "Oslo" {
+		t.Errorf("Expected goto to jump between questions, got %q and %q", name, city)
+	}
+}
+
+func TestScan(t *testing.T) {
+	f := NewForm(NewGroup(
+		NewInput().Key("name").Value(pointerTo("Ada")),
+		NewSelect[int]().Key("level").Options(NewOptions(1, 2, 3)...).Value(pointerTo(2)),
+		NewMultiSelect[string]().Key("toppings").Options(NewOptions("cheese", "ham")...).Value(&[]string{"ham"}),
+		NewConfirm().Key("Vegan").Value(pointerTo(true)),
+		NewInput().Key("password").Password(true).Value(pointerTo("hunter2")),
+	))
+	f.Init()
+
+	type Extra struct {
+		Password string
+	}
+	var dest struct {
+		Extra
+		Name     string   `huh:"name"`
+		Level    int64    `huh:"level"`
+		Toppings []string `huh:"toppings"`
+		Vegan    *bool
+		Ignored  string `huh:"-"`
+	}
+	dest.Ignored = "kept"
+	if err := f.Scan(&dest); err != nil {
+		t.Fatal(err)
+	}
+	if dest.Name != "Ada" || dest.Level != 2 || len(dest.Toppings) != 1 || dest.Toppings[0] != "ham" {
+		t.Errorf("Expected the tagged fields to be scanned, got %+v", dest)
+	}
+	if dest.Vegan == nil || !*dest.Vegan || dest.Password != "hunter2" || dest.Ignored != "kept" {
+		t.Errorf("Expected untagged and embedded fields to be matched by name, got %+v", dest)
+	}
+
+	var mismatch struct {
+		Level string `huh:"level"`
+	}
+	if err := f.Scan(&mismatch); err == nil || !strings.Contains(err.Error(), "level") {
+		t.Errorf("Expected an error naming the key that doesn't convert, got %v", err)
+	}
+	if err := f.Scan(dest); err == nil {
+		t.Error("Expected an error scanning into a struct that isn't a pointer.")
+	}
+}
+
+func TestSelectFiltering(t *testing.T) {
+	field := NewSelect[string]().Title("Fruit").Options(NewOptions("Apple", "Banana", "Cherry")...)
+	f := NewForm(NewGroup(field))
+	f = batchUpdate(f, f.Init()).(*Form)
+
+	f.Update(keys('/'))
+	f.Update(keys('A', 'N'))
+	view := f.View()
+	if !field.filtering || !strings.Contains(view, "Banana") || strings.Contains(view, "Cherry") {
+		t.Log(pretty.Render(view))
+		t.Fatal("Expected the filter to match keys ignoring case.")
+	}
+
+	f.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	f.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	view = f.View()
+	if field.filtering || !strings.Contains(view, "Apple") || !strings.Contains(view, "Cherry") {
+		t.Log(pretty.Render(view))
+		t.Fatal("Expected esc to clear the filter and list all options.")
+	}
+
+	field.Filtering(false)
+	f.Update(keys('/'))
+	if field.filtering || strings.Contains(f.View(), "/ filter") {
+		t.Log(pretty.Render(f.View()))
+		t.Error("Expected Filtering(false) to turn the filter off.")
+	}
+}
+
+func TestScrollIndicators(t *testing.T) {
+	field := NewSelect[int]().Title("Number").Options(NewOptions(1, 2, 3, 4, 5, 6)...).Height(3)
+	f := NewForm(NewGroup(field))
+	f = batchUpdate(f, f.Init()).(*Form)
+
+	// The field views leave out the help, which has arrows too.
+	view := field.View()
+	if strings.Contains(view, "↑") || !strings.Contains(view, "↓") || strings.Contains(view, "4") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected only the down arrow at the top of the options.")
+	}
+
+	for i := 0; i < 3; i++ {
+		f.Update(keys('j'))
+	}
+	view = field.View()
+	if !strings.Contains(view, "↑") || !strings.Contains(view, "↓") || !strings.Contains(view, "> 4") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected both arrows with the cursor in view in the middle of the options.")
+	}
+
+	multi := NewMultiSelect[int]().Title("Numbers").Options(NewOptions(1, 2, 3, 4)...).Height(2)
+	f = NewForm(NewGroup(multi))
+	f = batchUpdate(f, f.Init()).(*Form)
+	for i := 0; i < 3; i++ {
+		f.Update(keys('j'))
+	}
+	view = multi.View()
+	if !strings.Contains(view, "↑") || strings.Contains(view, "↓") || !strings.Contains(view, "> • 4") || strings.Contains(view, "2") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected the multi-select to scroll to its last options with only the up arrow.")
+	}
+
+	multi = NewMultiSelect[int]().Options(NewOptions(1, 2, 3, 4)...)
+	if view = multi.View(); strings.Contains(view, "↓") || !strings.Contains(view, "4") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected all options without arrows without a height.")
+	}
+}
+
+// customField implements only the methods Field has always had, like fields
+// written outside the package.
+type customField struct {
+	value   string
+	focused bool
+}
+
+func (c *customField) Init() tea.Cmd { return nil }
+
+func (c *customField) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok && msg.Type == tea.KeyEnter {
+		c.value = "done"
+		return c, nextField
+	}
+	return c, nil
+}
+
+func (c *customField) View() string              { return "custom: " + c.value }
+func (c *customField) Blur() tea.Cmd             { c.focused = false; return nil }
+func (c *customField) Focus() tea.Cmd            { c.focused = true; return nil }
+func (c *customField) Error() error              { return nil }
+func (c *customField) Run() error                { return nil }
+func (c *customField) KeyBinds() []key.Binding   { return nil }
+func (c *customField) WithTheme(*Theme) Field    { return c }
+func (c *customField) WithAccessible(bool) Field { return c }
+func (c *customField) WithKeyMap(*KeyMap) Field  { return c }
+func (c *customField) WithWidth(int) Field       { return c }
+func (c *customField) GetKey() string            { return "custom" }
+func (c *customField) GetValue() any             { return c.value }
+
+func TestCustomField(t *testing.T) {
+	var field Field = &customField{}
+	f := NewForm(NewGroup(field, NewInput().Title("Name"))).
+		WithDescriptionVisibility(DescriptionNever).
+		WithStrings(DefaultStrings())
+	f = batchUpdate(f, f.Init()).(*Form)
+
+	if view := f.View(); !strings.Contains(view, "custom:") {
+		t.Log(pretty.Render(view))
+		t.Fatal("Expected the custom field to render.")
+	}
+	batchUpdate(f.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+	batchUpdate(f.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+	if f.State != StateCompleted || f.GetString("custom") != "done" {
+		t.Errorf("Expected the form to complete with the custom field, got state %v and %v", f.State, f.Values())
+	}
+}
+
+func TestRequiredIf(t *testing.T) {
+	var contact string
+	phone := NewInput().Title("Phone").RequiredIf(func() bool { return contact == "phone" })
+	f := NewForm(
+		NewGroup(
+			NewSelect[string]().Options(NewOptions("email", "phone")...).Title("Contact").Value(&contact),
+			phone,
+		),
+	)
+	f = batchUpdate(f, f.Init()).(*Form)
+
+	m := batchUpdate(f.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+	batchUpdate(m.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+
+	if phone.Error() != nil || f.State != StateCompleted {
+		t.Error("Expected phone not to be required when contacting by email.")
+	}
+
+	contact = ""
+	f = NewForm(
+		NewGroup(
+			NewSelect[string]().Options(NewOptions("email", "phone")...).Title("Contact").Value(&contact),
+			phone,
+		),
+	)
+	f = batchUpdate(f, f.Init()).(*Form)
+
+	m = batchUpdate(f.Update(tea.KeyMsg{Type: tea.KeyDown}))
+	m = batchUpdate(m.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+	m = batchUpdate(m.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+	view := m.View()
+
+	if phone.Error() == nil || !strings.Contains(view, "this field is required") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected phone to be required when contacting by phone.")
+	}
+}
+
+func TestSetError(t *testing.T) {
+	email := "glen@charm.sh"
+	field := NewInput().Title("Email").Value(&email)
+	f := NewForm(NewGroup(field, NewInput().Title("Name")))
+	f = batchUpdate(f, f.Init()).(*Form)
+
+	field.SetError(fmt.Errorf("email already registered"))
+	view := f.View()
+
+	if !strings.Contains(view, "email already registered") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected set error to be shown.")
+	}
+
+	f.Update(tea.KeyMsg{Type: tea.KeyLeft})
+	if view := f.View(); !strings.Contains(view, "email already registered") {
+		t.Log(pretty.Render(view))
+		t.Error("Expected set error to be kept after a key that doesn't change the value.")
+	}
+
+	batchUpdate(f.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+
+	if field.Error() == nil {
+		t.Error("Expected set error to be kept while the value is unchanged.")
+	}
+
+	m := batchUpdate(f.Update(tea.KeyMsg{Type: tea.KeyBackspace}))
+	batchUpdate(m.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+
+	if field.Error() != nil {
+		t.Error("Expected set error to be cleared once the value changes.")
+	}
+
+	size := NewSelect[string]().Title("Size").Options(NewOptions("S", "M")...)
+	f = NewForm(NewGroup(size))
+	f = batchUpdate(f, f.Init()).(*Form)
+	size.SetError(fmt.Errorf("out of stock"))
+	f.Update(tea.KeyMsg{Type: tea.KeyDown})
+	if size.Error() == nil {
+		t.Error("Expected set error to be kept while moving the cursor.")
+	}
+}
+
+func TestHideGroup(t *testing.T) {
+	f := NewForm(
+		NewGroup(NewNote().Description("Foo")).WithHide(true),
+		NewGroup(NewNote().Description("Bar")),
+		NewGroup(NewNote().Description("Baz")),
+		NewGroup(NewNote().Description("Qux")).WithHideFunc(func() bool { return false }).WithHide(true),
+	)
+
+	f = batchUpdate(f, f.Init()).(*Form)
+
+	if v := f.View(); !strings.Contains(v, "Bar") {
+		t.Log(pretty.Render(v))
+		t.Error("expected Bar to not be hidden")
+	}
+
+	f.Update(nextGroup())
+
+	if v := f.View(); !strings.Contains(v, "Baz") {
+		t.Log(pretty.Render(v))
+		t.Error("expected Baz to not be hidden")
+	}
+
+	f = batchUpdate(f, nextGroup).(*Form)
+
+	if v := f.View(); strings.Contains(v, "Qux") {
+		t.Log(pretty.Render(v))
+		t.Error("expected Qux to be hidden")
+	}
+}
+
+func TestNote(t *testing.T) {
+	field := NewNote().Title("Taco").Description("How may we take your order?").Next(true)
+	f := NewForm(NewGroup(field))
+	f.theme.Focused.Base.Border(lipgloss.HiddenBorder())
+	f.Update(f.Init())
+
+	view := f.View()
+
+	if !strings.Contains(view, "Taco") {
+		t.Log(view)
+		t.Error("Expected field to contain Taco title.")
+	}
+
+	if !strings.Contains(view, "order?") {
+		t.Log(view)
+		t.Error("Expected field to contain Taco description.")
+	}
+
+	if !strings.Contains(view, "Next") {
+		t.Log(view)
+		t.Error("Expected field to contain next button")
+	}
+
+	if !strings.Contains(view, "enter next") {
+		t.Log(view)
+		t.Error("Expected field to contain help.")
+	}
+}
+
+func batchUpdate(m tea.Model, cmd tea.Cmd) tea.Model {
+	if cmd == nil {
+		return m
+	}
+
+	msg := runCmd(cmd)
+	if msg == nil {
+		return m
+	}
+
+	switch msg := msg.(type) {
+	case tea.BatchMsg:
+		for _, c := range msg {
+			m = batchUpdate(m, c)
+		}
+		return m
+	case cursor.BlinkMsg, spinner.TickMsg:
+		// Blinks and ticks would only ask for the next one.
+		return m
+	}
+
+	m, cmd = m.Update(msg)
+	return batchUpdate(m, cmd)
+}
+
+// blockingCmds are the code pointers of the commands that wait before they
+// return a message, cursor blinks and ticks, which tests don't run.
+var blockingCmds = func() map[uintptr]bool {
+	blink := cursor.New()
+	blink.SetMode(cursor.CursorBlink)
+	return map[uintptr]bool{
+		reflect.ValueOf(blink.BlinkCmd()).Pointer(): true,
+		reflect.ValueOf(tea.Tick(0, nil)).Pointer(): true,
+	}
+}()
+
+// runCmd runs a command, skipping those that block such as cursor blinks and
+// ticks.
+func runCmd(cmd tea.Cmd) tea.Msg {
+	if blockingCmds[reflect.ValueOf(cmd).Pointer()] {
+		return nil
+	}
+	return cmd()
 }
 
 func keys(runes ...rune) tea.KeyMsg {
@@ -0,0 +1,301 @@
+// Package huhtest drives huh forms from tests.
+//
+// A Driver wraps a form and sends it keys by name, the way they are written
+// in key bindings, running the commands the form returns until it settles.
+// Commands run synchronously, but for cursor blinks and ticks, which are
+// skipped:
+//
+//	d := huhtest.NewDriver(t, form)
+//	d.Send("down", "enter")
+//	d.ExpectValue("country", "Canada")
+//
+// Key sequences typed in a real terminal can be recorded with a Recorder and
+// replayed with Driver.Send.
+package huhtest
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/charmbracelet/bubbles/cursor"
+	"github.com/charmbracelet/bubbles/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/huh"
+)
+
+// blockingCmds are the code pointers of the commands that wait before they
+// return a message, cursor blinks and ticks, which a Driver doesn't run.
+var blockingCmds = func() map[uintptr]bool {
+	blink := cursor.New()
+	blink.SetMode(cursor.CursorBlink)
+	return map[uintptr]bool{
+		reflect.ValueOf(blink.BlinkCmd()).Pointer(): true,
+		reflect.ValueOf(tea.Tick(0, nil)).Pointer(): true,
+	}
+}()
+
+// keyTypes maps key names to key types, such as "enter" and "ctrl+c".
+var keyTypes = func() map[string]tea.KeyType {
+	types := map[string]tea.KeyType{
+		"space": tea.KeySpace,
+	}
+	for k := tea.KeyType(-100); k <= 127; k++ {
+		if name := k.String(); name != "" && k != tea.KeyRunes {
+			if _, ok := types[name]; !ok {
+				types[name] = k
+			}
+		}
+	}
+	return types
+}()
+
+// ParseKey returns the key message for a key name, as written in key
+// bindings: "enter", "shift+tab", "ctrl+c", "alt+f", "space" or a single
+// character such as "a".
+func ParseKey(name string) (tea.KeyMsg, error) {
+	var msg tea.KeyMsg
+	if t, ok := keyTypes[name]; ok {
+		msg.Type = t
+		return msg, nil
+	}
+	if strings.HasPrefix(name, "alt+") && len(name) > len("alt+") {
+		msg, err := ParseKey(strings.TrimPrefix(name, "alt+"))
+		msg.Alt = true
+		return msg, err
+	}
+	if utf8.RuneCountInString(name) == 1 {
+		msg.Type = tea.KeyRunes
+		msg.Runes = []rune(name)
+		return msg, nil
+	}
+	return msg, fmt.Errorf("huhtest: unknown key %q", name)
+}
+
+// Driver sends keys to a form and checks what it renders and binds.
+type Driver struct {
+	t       testing.TB
+	form    *huh.Form
+	keys    []string
+	timeout time.Duration
+	started bool
+}
+
+// NewDriver returns a new driver for the form, which it initializes before
+// anything else is sent.
+func NewDriver(t testing.TB, form *huh.Form) *Driver {
+	return &Driver{t: t, form: form}
+}
+
+// WithTimeout sets how long the driver waits for a command to return a
+// message before giving up on it, for forms whose own commands block, such as
+// a Select.WithOptionStatusFunc check waiting on a server. By default the
+// driver waits for as long as a command takes. Set before anything is sent,
+// the timeout applies to the form's initialization too.
+func (d *Driver) WithTimeout(timeout time.Duration) *Driver {
+	d.timeout = timeout
+	return d
+}
+
+// WithSize sends the form a window size, as a terminal does when a program
+// starts and when it is resized.
+func (d *Driver) WithSize(width, height int) *Driver {
+	d.t.Helper()
+	d.update(tea.WindowSizeMsg{Width: width, Height: height})
+	return d
+}
+
+// Send sends keys to the form by name, see ParseKey. It fails the test on an
+// unknown key.
+func (d *Driver) Send(keys ...string) *Driver {
+	d.t.Helper()
+	for _, key := range keys {
+		msg, err := ParseKey(key)
+		if err != nil {
+			d.t.Fatal(err)
+		}
+		d.keys = append(d.keys, key)
+		d.update(msg)
+	}
+	return d
+}
+
+// Type sends each character of text to the form as a key.
+func (d *Driver) Type(text string) *Driver {
+	d.t.Helper()
+	for _, r := range text {
+		d.Send(string(r))
+	}
+	return d
+}
+
+// Keys returns the names of the keys sent so far, to replay with Send.
+func (d *Driver) Keys() []string {
+	return append([]string(nil), d.keys...)
+}
+
+// Form returns the form being driven.
+func (d *Driver) Form() *huh.Form {
+	return d.form
+}
+
+// View returns the rendered form.
+func (d *Driver) View() string {
+	d.start()
+	return d.form.View()
+}
+
+// ExpectView fails the test if the rendered form doesn't contain each of
+// the given strings.
+func (d *Driver) ExpectView(substrs ...string) *Driver {
+	d.t.Helper()
+	view := d.View()
+	for _, s := range substrs {
+		if !strings.Contains(view, s) {
+			d.t.Errorf("huhtest: expected view to contain %q, got:\n%s", s, view)
+		}
+	}
+	return d
+}
+
+// ExpectNotView fails the test if the rendered form contains any of the
+// given strings.
+func (d *Driver) ExpectNotView(substrs ...string) *Driver {
+	d.t.Helper()
+	view := d.View()
+	for _, s := range substrs {
+		if strings.Contains(view, s) {
+			d.t.Errorf("huhtest: expected view not to contain %q, got:\n%s", s, view)
+		}
+	}
+	return d
+}
+
+// ExpectValue fails the test if the value of the field with the given key
+// isn't want.
+func (d *Driver) ExpectValue(key string, want any) *Driver {
+	d.t.Helper()
+	d.start()
+	if got := d.form.Get(key); !reflect.DeepEqual(got, want) {
+		d.t.Errorf("huhtest: expected %s to be %#v, got %#v", key, want, got)
+	}
+	return d
+}
+
+// ExpectState fails the test if the form isn't in the given state.
+func (d *Driver) ExpectState(want huh.FormState) *Driver {
+	d.t.Helper()
+	d.start()
+	if d.form.State != want {
+		d.t.Errorf("huhtest: expected form state %v, got %v", want, d.form.State)
+	}
+	return d
+}
+
+// start initializes the form, once.
+func (d *Driver) start() {
+	if !d.started {
+		d.started = true
+		d.run(d.form.Init())
+	}
+}
+
+// update sends a message to the form and runs the commands it returns.
+func (d *Driver) update(msg tea.Msg) {
+	d.start()
+	_, cmd := d.form.Update(msg)
+	d.run(cmd)
+}
+
+// run runs a command and sends its message back to the form, until no
+// commands are left. Quitting is left to the test.
+func (d *Driver) run(cmd tea.Cmd) {
+	if cmd == nil || blockingCmds[reflect.ValueOf(cmd).Pointer()] {
+		return
+	}
+	msg, ok := d.runCmd(cmd)
+	if !ok {
+		return
+	}
+
+	switch msg := msg.(type) {
+	case nil, tea.QuitMsg, cursor.BlinkMsg, spinner.TickMsg:
+	case tea.BatchMsg:
+		for _, c := range msg {
+			d.run(c)
+		}
+	default:
+		d.update(msg)
+	}
+}
+
+// runCmd runs a command, giving up on it after the timeout if there is one.
+func (d *Driver) runCmd(cmd tea.Cmd) (tea.Msg, bool) {
+	if d.timeout <= 0 {
+		return cmd(), true
+	}
+	ch := make(chan tea.Msg, 1)
+	go func() { ch <- cmd() }()
+	select {
+	case msg := <-ch:
+		return msg, true
+	case <-time.After(d.timeout):
+		return nil, false
+	}
+}
+
+// Recorder wraps a form to record the keys typed while it runs in a
+// terminal, to replay from a test with Driver.Send.
+//
+//	r := huhtest.NewRecorder(form)
+//	tea.NewProgram(r).Run()
+//	fmt.Printf("%q\n", r.Keys())
+type Recorder struct {
+	form *huh.Form
+	keys []string
+}
+
+// NewRecorder returns a new recorder for the form.
+func NewRecorder(form *huh.Form) *Recorder {
+	return &Recorder{form: form}
+}
+
+// Init initializes the form.
+func (r *Recorder) Init() tea.Cmd {
+	return r.form.Init()
+}
+
+// Update records keys and passes messages on to the form.
+func (r *Recorder) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		if msg.Type == tea.KeyRunes {
+			for _, c := range msg.Runes {
+				key := string(c)
+				if msg.Alt {
+					key = "alt+" + key
+				}
+				r.keys = append(r.keys, key)
+			}
+		} else if key := msg.String(); key != "" {
+			r.keys = append(r.keys, key)
+		}
+	}
+	_, cmd := r.form.Update(msg)
+	if r.form.State != huh.StateNormal {
+		cmd = tea.Batch(cmd, tea.Quit)
+	}
+	return r, cmd
+}
+
+// View renders the form.
+func (r *Recorder) View() string {
+	return r.form.View()
+}
+
+// Keys returns the names of the keys recorded so far.
+func (r *Recorder) Keys() []string {
+	return append([]string(nil), r.keys...)
+}
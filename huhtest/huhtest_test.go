@@ -0,0 +1,126 @@
+package huhtest_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/huh"
+	"github.com/charmbracelet/huh/huhtest"
+)
+
+func TestSelectNavigation(t *testing.T) {
+	var country string
+	form := huh.NewForm(huh.NewGroup(
+		huh.NewSelect[string]().
+			Key("country").
+			Title("Country").
+			Options(huh.NewOptions("United States", "Canada", "Mexico")...).
+			Value(&country),
+	))
+
+	d := huhtest.NewDriver(t, form)
+	d.ExpectView("> United States")
+	d.Send("down", "down").ExpectView("> Mexico")
+	d.Send("up").ExpectView("> Canada")
+	d.Send("enter").
+		ExpectState(huh.StateCompleted).
+		ExpectValue("country", "Canada")
+
+	if country != "Canada" {
+		t.Errorf("expected country to be bound to Canada, got %q", country)
+	}
+}
+
+func TestValidation(t *testing.T) {
+	var name string
+	form := huh.NewForm(huh.NewGroup(
+		huh.NewInput().
+			Key("name").
+			Title("Name").
+			Validate(func(s string) error {
+				if s == "" {
+					return errors.New("name is required")
+				}
+				return nil
+			}).
+			Value(&name),
+	))
+
+	d := huhtest.NewDriver(t, form)
+	d.Send("enter").
+		ExpectView("name is required").
+		ExpectState(huh.StateNormal)
+	d.Type("Frodo").Send("enter").
+		ExpectNotView("name is required").
+		ExpectState(huh.StateCompleted).
+		ExpectValue("name", "Frodo")
+}
+
+func TestReplay(t *testing.T) {
+	newForm := func() *huh.Form {
+		return huh.NewForm(huh.NewGroup(
+			huh.NewInput().Key("name"),
+			huh.NewConfirm().Key("sure"),
+		))
+	}
+
+	d := huhtest.NewDriver(t, newForm())
+	d.Type("Sam").Send("enter", "left", "enter")
+	d.ExpectState(huh.StateCompleted).ExpectValue("sure", true)
+
+	huhtest.NewDriver(t, newForm()).
+		Send(d.Keys()...).
+		ExpectState(huh.StateCompleted).
+		ExpectValue("name", "Sam").
+		ExpectValue("sure", true)
+}
+
+func TestTimeout(t *testing.T) {
+	block := make(chan struct{})
+	defer close(block)
+
+	form := huh.NewForm(huh.NewGroup(
+		huh.NewSelect[string]().
+			Key("region").
+			Options(huh.NewOptions("us-east", "eu-west")...).
+			WithOptionStatusFunc(func(option huh.Option[string]) tea.Cmd {
+				if option.Value == "us-east" {
+					return func() tea.Msg { <-block; return nil }
+				}
+				return nil
+			}),
+	))
+
+	huhtest.NewDriver(t, form).
+		WithTimeout(10*time.Millisecond).
+		Send("down", "enter").
+		ExpectState(huh.StateCompleted).
+		ExpectValue("region", "eu-west")
+}
+
+func TestParseKey(t *testing.T) {
+	tests := map[string]tea.KeyMsg{
+		"enter":     {Type: tea.KeyEnter},
+		"shift+tab": {Type: tea.KeyShiftTab},
+		"ctrl+c":    {Type: tea.KeyCtrlC},
+		"space":     {Type: tea.KeySpace},
+		"a":         {Type: tea.KeyRunes, Runes: []rune("a")},
+		"alt+f":     {Type: tea.KeyRunes, Runes: []rune("f"), Alt: true},
+	}
+	for name, want := range tests {
+		got, err := huhtest.ParseKey(name)
+		if err != nil {
+			t.Errorf("%s: %v", name, err)
+			continue
+		}
+		if got.String() != want.String() || got.Type != want.Type {
+			t.Errorf("%s: expected %v, got %v", name, want, got)
+		}
+	}
+
+	if _, err := huhtest.ParseKey("hyper+x"); err == nil {
+		t.Error("expected an error for an unknown key")
+	}
+}
@@ -0,0 +1,106 @@
+package huh
+
+import (
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// IdleAction is what a form does once it has been idle for its idle timeout.
+type IdleAction int
+
+const (
+	// IdleSubmit submits the form with the values as they are, validating
+	// them as submitting does. An invalid form is left open on its first
+	// error instead.
+	IdleSubmit IdleAction = iota
+
+	// IdleAbort aborts the form.
+	IdleAbort
+)
+
+// idleCountdown is how long before the idle timeout a countdown is shown.
+const idleCountdown = 5 * time.Second
+
+// idleTickMsg is sent every second to check whether the form is idle.
+type idleTickMsg time.Time
+
+// idleTick is the command checking whether the form is idle after a second.
+func idleTick() tea.Cmd {
+	return tea.Tick(time.Second, func(t time.Time) tea.Msg {
+		return idleTickMsg(t)
+	})
+}
+
+// WithIdleTimeout sets the form to submit or abort, depending on the action,
+// once there has been no key input for the given duration. This is useful for
+// unattended forms, such as kiosks. A countdown is shown for the last few
+// seconds. The timeout is held off while a field is busy, such as a Select
+// loading its options.
+//
+// The idle timeout doesn't apply in accessible mode.
+func (f *Form) WithIdleTimeout(timeout time.Duration, action IdleAction) *Form {
+	f.idleTimeout = timeout
+	f.idleAction = action
+	return f
+}
+
+// busier is implemented by fields that can be busy, such as a Text open in
+// the editor.
+type busier interface {
+	busy() bool
+}
+
+// busy returns whether a field of a group that isn't hidden is busy.
+func (f *Form) busy() bool {
+	for _, group := range f.groups {
+		if group.hide != nil && group.hide() {
+			continue
+		}
+		for _, field := range group.fields {
+			if b, ok := field.(busier); ok && b.busy() {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// resetIdle restarts the idle timeout from the given time.
+func (f *Form) resetIdle(now time.Time) {
+	if f.idleTimeout > 0 {
+		f.idleDeadline = now.Add(f.idleTimeout)
+	}
+}
+
+// idle performs the idle action of the form.
+func (f *Form) idle() tea.Cmd {
+	if f.idleAction == IdleAbort {
+		return f.abort()
+	}
+
+	// Submitting validates the form as the submit key does. An invalid form
+	// stays open on its first error, with the timeout restarted.
+	cmd := f.submitAll()
+	if f.State != StateCompleted {
+		f.resetIdle(time.Now())
+		return tea.Batch(cmd, idleTick())
+	}
+	return cmd
+}
+
+// idleView renders the countdown of the idle timeout, if it's close.
+func (f *Form) idleView(now time.Time) string {
+	remaining := f.idleDeadline.Sub(now)
+	if f.idleTimeout <= 0 || remaining > idleCountdown {
+		return ""
+	}
+
+	seconds := int(remaining.Round(time.Second) / time.Second)
+	text := f.strings.SubmittingIn
+	if f.idleAction == IdleAbort {
+		text = f.strings.ClosingIn
+	}
+	return "\n" + f.theme.Countdown.Render(fmt.Sprintf(text, seconds))
+}
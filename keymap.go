@@ -1,23 +1,51 @@
 package huh
 
-import "github.com/charmbracelet/bubbles/key"
+import (
+	"fmt"
+	"unicode"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+)
 
 // KeyMap is the keybindings to navigate the form.
 type KeyMap struct {
 	Quit key.Binding
+	Help key.Binding
+	Copy key.Binding
+
+	// Submit validates every field and submits the form from anywhere in it,
+	// or goes to the first error. It is disabled by default.
+	Submit key.Binding
+
+	// Search opens the search of the titles of the form's fields, in forms
+	// with field search, see Form.WithFieldSearch. Like Help, it gives way to
+	// typed text and to the bindings of the focused field.
+	Search key.Binding
+
+	// DescriptionUp and DescriptionDown scroll the description of the
+	// focused field, see Input.WithDescriptionHeight.
+	DescriptionUp   key.Binding
+	DescriptionDown key.Binding
 
 	Input       InputKeyMap
 	Text        TextKeyMap
 	Select      SelectKeyMap
 	MultiSelect MultiSelectKeyMap
+	Tree        TreeKeyMap
+	Slider      SliderKeyMap
 	Note        NoteKeyMap
 	Confirm     ConfirmKeyMap
+	Button      ButtonKeyMap
+	Embed       EmbedKeyMap
 }
 
 // InputKeyMap is the keybindings for input fields.
 type InputKeyMap struct {
-	Next key.Binding
-	Prev key.Binding
+	Next        key.Binding
+	Prev        key.Binding
+	HistoryPrev key.Binding
+	HistoryNext key.Binding
 }
 
 // TextKeyMap is the keybindings for text fields.
@@ -34,18 +62,51 @@ type SelectKeyMap struct {
 	Prev        key.Binding
 	Up          key.Binding
 	Down        key.Binding
+	Left        key.Binding
+	Right       key.Binding
+	PageUp      key.Binding
+	PageDown    key.Binding
+	Home        key.Binding
+	End         key.Binding
 	Filter      key.Binding
 	SetFilter   key.Binding
 	ClearFilter key.Binding
+	Tooltip     key.Binding
+	Open        key.Binding
+	Reset       key.Binding
+	Accept      key.Binding
+	Decline     key.Binding
 }
 
 // MultiSelectKeyMap is the keybindings for multi-select fields.
 type MultiSelectKeyMap struct {
-	Next   key.Binding
-	Prev   key.Binding
-	Up     key.Binding
-	Down   key.Binding
-	Toggle key.Binding
+	Next      key.Binding
+	Prev      key.Binding
+	Up        key.Binding
+	Down      key.Binding
+	Toggle    key.Binding
+	ToggleAll key.Binding
+}
+
+// TreeKeyMap is the keybindings for tree fields.
+type TreeKeyMap struct {
+	Next    key.Binding
+	Prev    key.Binding
+	Up      key.Binding
+	Down    key.Binding
+	Descend key.Binding
+	Ascend  key.Binding
+}
+
+// SliderKeyMap is the keybindings for slider fields. The larger steps move by
+// the slider's big step.
+type SliderKeyMap struct {
+	Next         key.Binding
+	Prev         key.Binding
+	Decrease     key.Binding
+	Increase     key.Binding
+	DecreaseMore key.Binding
+	IncreaseMore key.Binding
 }
 
 // NoteKeyMap is the keybindings for note fields.
@@ -61,13 +122,37 @@ type ConfirmKeyMap struct {
 	Toggle key.Binding
 }
 
+// ButtonKeyMap is the keybindings for button fields.
+type ButtonKeyMap struct {
+	Next  key.Binding
+	Prev  key.Binding
+	Left  key.Binding
+	Right key.Binding
+	Press key.Binding
+}
+
+// EmbedKeyMap is the keybindings for embed fields. Tab is used to move on as
+// embedded models often use enter themselves.
+type EmbedKeyMap struct {
+	Next key.Binding
+	Prev key.Binding
+}
+
 // NewDefaultKeyMap returns a new default keymap.
 func NewDefaultKeyMap() *KeyMap {
 	return &KeyMap{
-		Quit: key.NewBinding(key.WithKeys("ctrl+c")),
+		Quit:            key.NewBinding(key.WithKeys("ctrl+c")),
+		Help:            key.NewBinding(key.WithKeys("?"), key.WithHelp("?", "more")),
+		Copy:            key.NewBinding(key.WithKeys("ctrl+y"), key.WithHelp("ctrl+y", "copy")),
+		Submit:          key.NewBinding(key.WithKeys("ctrl+s"), key.WithHelp("ctrl+s", "submit"), key.WithDisabled()),
+		Search:          key.NewBinding(key.WithKeys("/", "ctrl+f"), key.WithHelp("/", "search fields")),
+		DescriptionUp:   key.NewBinding(key.WithKeys("ctrl+up"), key.WithHelp("ctrl+↑", "scroll description up")),
+		DescriptionDown: key.NewBinding(key.WithKeys("ctrl+down"), key.WithHelp("ctrl+↓", "scroll description down")),
 		Input: InputKeyMap{
-			Next: key.NewBinding(key.WithKeys("enter", "tab"), key.WithHelp("enter", "next")),
-			Prev: key.NewBinding(key.WithKeys("shift+tab"), key.WithHelp("shift+tab", "back")),
+			Next:        key.NewBinding(key.WithKeys("enter", "tab"), key.WithHelp("enter", "next")),
+			Prev:        key.NewBinding(key.WithKeys("shift+tab"), key.WithHelp("shift+tab", "back")),
+			HistoryPrev: key.NewBinding(key.WithKeys("up"), key.WithHelp("↑", "older")),
+			HistoryNext: key.NewBinding(key.WithKeys("down"), key.WithHelp("↓", "newer")),
 		},
 		Text: TextKeyMap{
 			Next:    key.NewBinding(key.WithKeys("tab", "enter"), key.WithHelp("enter", "next")),
@@ -80,16 +165,44 @@ func NewDefaultKeyMap() *KeyMap {
 			Prev:        key.NewBinding(key.WithKeys("shift+tab"), key.WithHelp("shift+tab", "back")),
 			Up:          key.NewBinding(key.WithKeys("up", "k", "ctrl+k", "ctrl+p"), key.WithHelp("↑", "up")),
 			Down:        key.NewBinding(key.WithKeys("down", "j", "ctrl+j", "ctrl+n"), key.WithHelp("↓", "down")),
+			Left:        key.NewBinding(key.WithKeys("left", "h"), key.WithHelp("←", "left")),
+			Right:       key.NewBinding(key.WithKeys("right", "l"), key.WithHelp("→", "right")),
+			PageUp:      key.NewBinding(key.WithKeys("pgup"), key.WithHelp("pgup", "page up")),
+			PageDown:    key.NewBinding(key.WithKeys("pgdown"), key.WithHelp("pgdn", "page down")),
+			Home:        key.NewBinding(key.WithKeys("home"), key.WithHelp("home", "first")),
+			End:         key.NewBinding(key.WithKeys("end"), key.WithHelp("end", "last")),
 			Filter:      key.NewBinding(key.WithKeys("/"), key.WithHelp("/", "filter")),
 			SetFilter:   key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "set filter"), key.WithDisabled()),
 			ClearFilter: key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "clear filter"), key.WithDisabled()),
+			Tooltip:     key.NewBinding(key.WithKeys("i"), key.WithHelp("i", "info")),
+			Open:        key.NewBinding(key.WithKeys("enter", " "), key.WithHelp("enter", "open")),
+			Reset:       key.NewBinding(key.WithKeys("ctrl+r"), key.WithHelp("ctrl+r", "reset")),
+			Accept:      key.NewBinding(key.WithKeys("y", "Y"), key.WithHelp("y", "yes")),
+			Decline:     key.NewBinding(key.WithKeys("n", "N", "esc"), key.WithHelp("n", "no")),
 		},
 		MultiSelect: MultiSelectKeyMap{
-			Next:   key.NewBinding(key.WithKeys("enter", "tab"), key.WithHelp("enter", "confirm")),
-			Prev:   key.NewBinding(key.WithKeys("shift+tab"), key.WithHelp("shift+tab", "back")),
-			Toggle: key.NewBinding(key.WithKeys(" ", "x"), key.WithHelp("x", "toggle")),
-			Up:     key.NewBinding(key.WithKeys("up", "k", "ctrl+p"), key.WithHelp("↑", "up")),
-			Down:   key.NewBinding(key.WithKeys("down", "j", "ctrl+n"), key.WithHelp("↓", "down")),
+			Next:      key.NewBinding(key.WithKeys("enter", "tab"), key.WithHelp("enter", "confirm")),
+			Prev:      key.NewBinding(key.WithKeys("shift+tab"), key.WithHelp("shift+tab", "back")),
+			Toggle:    key.NewBinding(key.WithKeys(" ", "x"), key.WithHelp("x", "toggle")),
+			Up:        key.NewBinding(key.WithKeys("up", "k", "ctrl+p"), key.WithHelp("↑", "up")),
+			Down:      key.NewBinding(key.WithKeys("down", "j", "ctrl+n"), key.WithHelp("↓", "down")),
+			ToggleAll: key.NewBinding(key.WithKeys("ctrl+a"), key.WithHelp("ctrl+a", "toggle all")),
+		},
+		Tree: TreeKeyMap{
+			Next:    key.NewBinding(key.WithKeys("enter", "tab"), key.WithHelp("enter", "select")),
+			Prev:    key.NewBinding(key.WithKeys("shift+tab"), key.WithHelp("shift+tab", "back")),
+			Up:      key.NewBinding(key.WithKeys("up", "k", "ctrl+p"), key.WithHelp("↑", "up")),
+			Down:    key.NewBinding(key.WithKeys("down", "j", "ctrl+n"), key.WithHelp("↓", "down")),
+			Descend: key.NewBinding(key.WithKeys("right", "l"), key.WithHelp("→", "open")),
+			Ascend:  key.NewBinding(key.WithKeys("left", "h", "backspace"), key.WithHelp("←", "parent")),
+		},
+		Slider: SliderKeyMap{
+			Next:         key.NewBinding(key.WithKeys("enter", "tab"), key.WithHelp("enter", "next")),
+			Prev:         key.NewBinding(key.WithKeys("shift+tab"), key.WithHelp("shift+tab", "back")),
+			Decrease:     key.NewBinding(key.WithKeys("left", "h"), key.WithHelp("←", "decrease")),
+			Increase:     key.NewBinding(key.WithKeys("right", "l"), key.WithHelp("→", "increase")),
+			DecreaseMore: key.NewBinding(key.WithKeys("shift+left", "pgdown", "H"), key.WithHelp("shift+←", "decrease more")),
+			IncreaseMore: key.NewBinding(key.WithKeys("shift+right", "pgup", "L"), key.WithHelp("shift+→", "increase more")),
 		},
 		Note: NoteKeyMap{
 			Next: key.NewBinding(key.WithKeys("enter", "tab"), key.WithHelp("enter", "next")),
@@ -100,5 +213,184 @@ func NewDefaultKeyMap() *KeyMap {
 			Prev:   key.NewBinding(key.WithKeys("shift+tab"), key.WithHelp("shift+tab", "back")),
 			Toggle: key.NewBinding(key.WithKeys("h", "l", "right", "left"), key.WithHelp("←/→", "toggle")),
 		},
+		Button: ButtonKeyMap{
+			Next:  key.NewBinding(key.WithKeys("tab"), key.WithHelp("tab", "next")),
+			Prev:  key.NewBinding(key.WithKeys("shift+tab"), key.WithHelp("shift+tab", "back")),
+			Left:  key.NewBinding(key.WithKeys("left", "h"), key.WithHelp("←", "left")),
+			Right: key.NewBinding(key.WithKeys("right", "l"), key.WithHelp("→", "right")),
+			Press: key.NewBinding(key.WithKeys("enter", " "), key.WithHelp("enter", "press")),
+		},
+		Embed: EmbedKeyMap{
+			Next: key.NewBinding(key.WithKeys("tab"), key.WithHelp("tab", "next")),
+			Prev: key.NewBinding(key.WithKeys("shift+tab"), key.WithHelp("shift+tab", "back")),
+		},
+	}
+}
+
+// Remap replaces the key from with the keys to in every binding of the
+// keymap, such as to move filtering off "/" on keyboards where it needs a
+// modifier, and returns the keymap. With no keys to, from is unbound.
+//
+// Keys with a modifier, such as "ctrl+f" or "alt+/", and named keys, such as
+// "f2", are safe replacements: text fields take plain characters as typed
+// text, so bindings to them never fire while text is entered. Conflicts
+// reports such bindings.
+func (k *KeyMap) Remap(from string, to ...string) *KeyMap {
+	remapKeys(k.bindings(), []keyRemap{{from, to}})
+	return k
+}
+
+// Conflicts returns warnings about the bindings of the keymap that can't work
+// as bound: bindings that are active while text is entered and bound to plain
+// characters, which text fields take as typed text instead, and bindings that
+// are active together and share a key.
+func (k *KeyMap) Conflicts() []string {
+	form := []namedBinding{
+		{"Quit", &k.Quit},
+		{"Copy", &k.Copy},
+		{"Submit", &k.Submit},
+		{"DescriptionUp", &k.DescriptionUp},
+		{"DescriptionDown", &k.DescriptionDown},
+	}
+	input := []namedBinding{
+		{"Input.Next", &k.Input.Next},
+		{"Input.Prev", &k.Input.Prev},
+		{"Input.HistoryPrev", &k.Input.HistoryPrev},
+		{"Input.HistoryNext", &k.Input.HistoryNext},
+	}
+	text := []namedBinding{
+		{"Text.Next", &k.Text.Next},
+		{"Text.Prev", &k.Text.Prev},
+		{"Text.NewLine", &k.Text.NewLine},
+		{"Text.Editor", &k.Text.Editor},
+	}
+
+	var warnings []string
+	reported := map[string]bool{}
+	for _, b := range append(append(append([]namedBinding{}, form...), input...), text...) {
+		for _, key := range enabledKeys(b.binding) {
+			if isTypedKey(key) && !reported[b.name] {
+				reported[b.name] = true
+				warnings = append(warnings, fmt.Sprintf("%s is bound to %q, which text fields take as typed text", b.name, key))
+			}
+		}
+	}
+	for _, active := range [][]namedBinding{append(form, input...), append(form, text...)} {
+		owner := map[string]string{}
+		for _, b := range active {
+			for _, key := range enabledKeys(b.binding) {
+				other, ok := owner[key]
+				pair := other + "," + b.name
+				if ok && other != b.name && !reported[pair] {
+					reported[pair] = true
+					warnings = append(warnings, fmt.Sprintf("%s and %s are both bound to %q", other, b.name, key))
+				}
+				if !ok {
+					owner[key] = b.name
+				}
+			}
+		}
+	}
+	return warnings
+}
+
+// namedBinding is a binding of a keymap along with its name, for warnings.
+type namedBinding struct {
+	name    string
+	binding *key.Binding
+}
+
+// enabledKeys returns the keys of a binding, if it is enabled.
+func enabledKeys(b *key.Binding) []string {
+	if !b.Enabled() {
+		return nil
+	}
+	return b.Keys()
+}
+
+// isTypedKey reports whether a key, as a binding names it, is a plain
+// character.
+func isTypedKey(k string) bool {
+	runes := []rune(k)
+	return len(runes) == 1 && unicode.IsPrint(runes[0])
+}
+
+// typedText reports whether a key message is a plain character, which fields
+// taking typed text keep for themselves instead of matching bindings.
+func typedText(msg tea.KeyMsg) bool {
+	return (msg.Type == tea.KeyRunes && !msg.Alt) || msg.Type == tea.KeySpace
+}
+
+// keyRemap replaces the key from with the keys to in the bindings of a
+// field, see Select.RemapKey.
+type keyRemap struct {
+	from string
+	to   []string
+}
+
+// remapKeys applies remaps, in order, to bindings.
+func remapKeys(bindings []*key.Binding, remaps []keyRemap) {
+	for _, remap := range remaps {
+		for _, b := range bindings {
+			var keys []string
+			found := false
+			for _, k := range b.Keys() {
+				if k == remap.from {
+					keys = append(keys, remap.to...)
+					found = true
+					continue
+				}
+				keys = append(keys, k)
+			}
+			if !found {
+				continue
+			}
+			b.SetKeys(keys...)
+			if help := b.Help(); help.Key == remap.from && len(remap.to) > 0 {
+				b.SetHelp(remap.to[0], help.Desc)
+			}
+		}
+	}
+}
+
+// bindings returns every binding of the keymap.
+func (k *KeyMap) bindings() []*key.Binding {
+	bindings := []*key.Binding{
+		&k.Quit, &k.Help, &k.Copy, &k.Submit, &k.Search, &k.DescriptionUp, &k.DescriptionDown,
+	}
+	bindings = append(bindings, k.Input.bindings()...)
+	bindings = append(bindings, k.Text.bindings()...)
+	bindings = append(bindings, k.Select.bindings()...)
+	bindings = append(bindings,
+		&k.MultiSelect.Next, &k.MultiSelect.Prev, &k.MultiSelect.Up, &k.MultiSelect.Down,
+		&k.MultiSelect.Toggle, &k.MultiSelect.ToggleAll,
+		&k.Tree.Next, &k.Tree.Prev, &k.Tree.Up, &k.Tree.Down, &k.Tree.Descend, &k.Tree.Ascend,
+		&k.Slider.Next, &k.Slider.Prev, &k.Slider.Decrease, &k.Slider.Increase,
+		&k.Slider.DecreaseMore, &k.Slider.IncreaseMore,
+		&k.Note.Next, &k.Note.Prev,
+		&k.Confirm.Next, &k.Confirm.Prev, &k.Confirm.Toggle,
+		&k.Button.Next, &k.Button.Prev, &k.Button.Left, &k.Button.Right, &k.Button.Press,
+		&k.Embed.Next, &k.Embed.Prev,
+	)
+	return bindings
+}
+
+// bindings returns every binding of the input keymap.
+func (k *InputKeyMap) bindings() []*key.Binding {
+	return []*key.Binding{&k.Next, &k.Prev, &k.HistoryPrev, &k.HistoryNext}
+}
+
+// bindings returns every binding of the text keymap.
+func (k *TextKeyMap) bindings() []*key.Binding {
+	return []*key.Binding{&k.Next, &k.Prev, &k.NewLine, &k.Editor}
+}
+
+// bindings returns every binding of the select keymap.
+func (k *SelectKeyMap) bindings() []*key.Binding {
+	return []*key.Binding{
+		&k.Next, &k.Prev, &k.Up, &k.Down, &k.Left, &k.Right,
+		&k.PageUp, &k.PageDown, &k.Home, &k.End,
+		&k.Filter, &k.SetFilter, &k.ClearFilter,
+		&k.Tooltip, &k.Open, &k.Reset, &k.Accept, &k.Decline,
 	}
 }
@@ -0,0 +1,39 @@
+package huh
+
+import (
+	"os"
+	"strconv"
+)
+
+// Link returns text that links to a URL, for use in titles, descriptions and
+// notes, such as for a "see the docs" hint.
+//
+// On terminals known to support OSC 8 hyperlinks the text is clickable,
+// others show the text followed by the URL in parentheses. So do accessible
+// forms.
+func Link(text, url string) string {
+	if !supportsHyperlinks() {
+		return text + " (" + url + ")"
+	}
+	// The sequences are written once the form is rendered, see deferEscape.
+	return deferEscape("\x1b]8;;"+url+"\x1b\\", "") + text + deferEscape("\x1b]8;;\x1b\\", " ("+url+")")
+}
+
+// supportsHyperlinks reports whether the terminal is known to support OSC 8
+// hyperlinks. Like for graphics, terminals are only detected by their
+// environment, and multiplexers are assumed not to pass the links through.
+func supportsHyperlinks() bool {
+	if os.Getenv("TMUX") != "" || os.Getenv("STY") != "" || os.Getenv("TERM") == "dumb" {
+		return false
+	}
+	if os.Getenv("KITTY_WINDOW_ID") != "" || os.Getenv("WT_SESSION") != "" {
+		return true
+	}
+	switch os.Getenv("TERM_PROGRAM") {
+	case "iTerm.app", "WezTerm", "vscode", "ghostty":
+		return true
+	}
+	// VTE based terminals, such as GNOME Terminal, support them since 0.50.
+	vte, err := strconv.Atoi(os.Getenv("VTE_VERSION"))
+	return err == nil && vte >= 5000
+}
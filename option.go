@@ -1,12 +1,26 @@
 package huh
 
-import "fmt"
+import (
+	"fmt"
+	"reflect"
+)
 
 // Option is an option for select fields.
 type Option[T any] struct {
-	Key      string
-	Value    T
-	selected bool
+	Key         string
+	Value       T
+	selected    bool
+	description string
+	children    []Option[T]
+	accelerator rune
+	hide        func() bool
+	confirm     string
+	group       string
+	header      bool
+
+	// index is the position of the option in the options of its select
+	// field, which tells apart options with the same key.
+	index int
 }
 
 // NewOptions returns new options from a list of values.
@@ -32,7 +46,66 @@ func (o Option[T]) Selected(selected bool) Option[T] {
 	return o
 }
 
+// Description sets the description of the option. Select fields show it in a
+// tooltip on demand to keep the list of options compact.
+func (o Option[T]) Description(description string) Option[T] {
+	o.description = description
+	return o
+}
+
+// Accelerator sets a key that chooses the option of a select field when
+// pressed. Keys that the select field already binds, such as j and k, can't be
+// accelerators.
+func (o Option[T]) Accelerator(r rune) Option[T] {
+	o.accelerator = r
+	return o
+}
+
+// WithHideFunc sets a function reporting whether the option is hidden. Select
+// fields leave hidden options out of the list, navigation and accessible
+// numbering, checking again each time they are focused.
+func (o Option[T]) WithHideFunc(hide func() bool) Option[T] {
+	o.hide = hide
+	return o
+}
+
+// ConfirmMessage sets a question that select fields ask before moving on from
+// the option, for options that trigger something destructive. Declining returns
+// to the list of options. The option is only ever chosen once the question is
+// accepted, so going back from it, or pressing its accelerator without
+// advancing, leaves the value as it was.
+func (o Option[T]) ConfirmMessage(message string) Option[T] {
+	o.confirm = message
+	return o
+}
+
+// Group sets the group of the option. Select fields with collapsible groups,
+// see Select.WithCollapsibleGroups, list the options of each group under a
+// header that expands and collapses them.
+func (o Option[T]) Group(name string) Option[T] {
+	o.group = name
+	return o
+}
+
+// hidden returns whether the option is hidden.
+func (o Option[T]) hidden() bool {
+	return o.hide != nil && o.hide()
+}
+
+// Children sets the options nested under the option, which makes it a branch
+// of a tree field rather than a value to choose.
+func (o Option[T]) Children(children ...Option[T]) Option[T] {
+	o.children = children
+	return o
+}
+
 // String returns the key of the option.
 func (o Option[T]) String() string {
 	return o.Key
 }
+
+// equal reports whether two option values are equal. It is used to match the
+// values bound to a field against its options.
+func equal[T any](a, b T) bool {
+	return reflect.DeepEqual(a, b)
+}
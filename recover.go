@@ -0,0 +1,47 @@
+package huh
+
+import "fmt"
+
+// recoverer is implemented by fields that call user callbacks, such as
+// validation functions, which can recover from their panics.
+type recoverer interface {
+	withRecover(bool)
+}
+
+// recoverAsError recovers from a panic, storing it in err as an error with
+// the recovered message. It does nothing unless on, letting the panic go on.
+// It must be deferred directly.
+func recoverAsError(on bool, err *error) {
+	if !on {
+		return
+	}
+	if r := recover(); r != nil {
+		*err = fmt.Errorf("panic: %v", r)
+	}
+}
+
+// WithRecover sets whether the form recovers from panics in user callbacks,
+// such as validation functions and OptionsFunc, which is the default. A panic
+// becomes the error of its field, so that the user can correct the value,
+// and a panicking event handler drops the event.
+//
+// Turn it off to fail fast instead.
+func (f *Form) WithRecover(v bool) *Form {
+	f.failFast = !v
+	for _, group := range f.groups {
+		group.WithRecover(v)
+	}
+	return f
+}
+
+// WithRecover sets whether the group recovers from panics in user callbacks,
+// see Form.WithRecover.
+func (g *Group) WithRecover(v bool) *Group {
+	g.failFast = !v
+	for _, field := range g.fields {
+		if r, ok := field.(recoverer); ok {
+			r.withRecover(v)
+		}
+	}
+	return g
+}
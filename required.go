@@ -0,0 +1,82 @@
+package huh
+
+import (
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// requirer is implemented by fields that can be required, see
+// Input.RequiredIf.
+type requirer interface {
+	// missing reports whether the field is required but still empty.
+	missing() bool
+}
+
+// WithRequiredSummary sets whether submitting a form with required fields
+// that are still empty lists them by title, focusing the first, instead of
+// completing. The list updates as the fields are filled in.
+func (f *Form) WithRequiredSummary(v bool) *Form {
+	f.requiredSummary = v
+	return f
+}
+
+// missingFields returns the required fields that are still empty in the
+// groups that aren't hidden, along with the positions of the first.
+func (f *Form) missingFields() (fields []Field, group, field int) {
+	for i, g := range f.groups {
+		if g.hide != nil && g.hide() {
+			continue
+		}
+		for j, fld := range g.fields {
+			if r, ok := fld.(requirer); ok && r.missing() {
+				if len(fields) == 0 {
+					group, field = i, j
+				}
+				fields = append(fields, fld)
+			}
+		}
+	}
+	return fields, group, field
+}
+
+// focusMissing focuses the first required field that is still empty, if the
+// form lists them on submit, reporting whether there is one.
+func (f *Form) focusMissing() (tea.Cmd, bool) {
+	if !f.requiredSummary {
+		return nil, false
+	}
+	fields, i, j := f.missingFields()
+	if len(fields) == 0 {
+		return nil, false
+	}
+	f.summarizing = true
+
+	current := f.groups[f.paginator.Page]
+	current.fields[current.paginator.Page].Blur()
+	f.paginator.Page = i
+	f.groups[i].paginator.Page = j
+	return f.groups[i].fields[j].Focus(), true
+}
+
+// requiredSummaryView renders the required fields that are still empty,
+// once submitting was stopped by them.
+func (f *Form) requiredSummaryView() string {
+	if !f.summarizing {
+		return ""
+	}
+	fields, _, _ := f.missingFields()
+	if len(fields) == 0 {
+		return ""
+	}
+
+	lines := []string{f.strings.MissingRequired}
+	for _, field := range fields {
+		title := fieldTitle(field)
+		if title == "" {
+			title = field.GetKey()
+		}
+		lines = append(lines, "• "+title)
+	}
+	return "\n" + f.theme.Missing.Render(strings.Join(lines, "\n"))
+}
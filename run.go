@@ -6,3 +6,57 @@ func Run(field Field) error {
 	form := NewForm(group).WithShowHelp(false)
 	return form.Run()
 }
+
+// RunForm runs a form with the given groups and returns the values of the
+// fields with keys, by key, so that fields don't need bound values.
+//
+// Fields are validated as usual. Unlike Form.Values, secrets aren't redacted.
+// If the user aborts the form, the error is ErrUserAborted and no values are
+// returned.
+func RunForm(groups ...*Group) (map[string]any, error) {
+	form := NewForm(groups...)
+	if err := form.Run(); err != nil {
+		return nil, err
+	}
+	return form.valuesThrough(len(form.groups)-1, Field.GetValue), nil
+}
+
+// RunSelect runs a select field with the given title and options and returns
+// the chosen value.
+func RunSelect[T any](title string, options ...Option[T]) (T, error) {
+	var value T
+	err := Run(NewSelect[T]().Title(title).Options(options...).Value(&value))
+	return value, err
+}
+
+// RunMultiSelect runs a multi-select field with the given title and options
+// and returns the chosen values.
+func RunMultiSelect[T any](title string, options ...Option[T]) ([]T, error) {
+	var value []T
+	err := Run(NewMultiSelect[T]().Title(title).Options(options...).Value(&value))
+	return value, err
+}
+
+// RunInput runs an input field with the given title and returns the entered
+// value.
+func RunInput(title string) (string, error) {
+	var value string
+	err := Run(NewInput().Title(title).Value(&value))
+	return value, err
+}
+
+// RunText runs a text field with the given title and returns the entered
+// value.
+func RunText(title string) (string, error) {
+	var value string
+	err := Run(NewText().Title(title).Value(&value))
+	return value, err
+}
+
+// RunConfirm runs a confirm field with the given title and returns whether the
+// user confirmed.
+func RunConfirm(title string) (bool, error) {
+	var value bool
+	err := Run(NewConfirm().Title(title).Value(&value))
+	return value, err
+}
@@ -0,0 +1,64 @@
+package huh
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// optionLister is implemented by fields with options to choose from, which
+// are listed in the answer-file template of a form.
+type optionLister interface {
+	optionValues() []string
+}
+
+// ToScript returns an answer-file template for the form, to fill in and run
+// the form without prompting.
+//
+// Each field with a key is written as a "key = value" line preceded by
+// comments with its title, the type of its value and its options, if any.
+// Values are written as JSON, starting from the current values of the fields
+// so that defaults carry over. Notes and fields without keys are left out.
+func (f *Form) ToScript() string {
+	var sb strings.Builder
+	for _, group := range f.groups {
+		for _, field := range group.fields {
+			key := field.GetKey()
+			if _, ok := field.(*Note); ok || key == "" {
+				continue
+			}
+
+			if sb.Len() > 0 {
+				sb.WriteString("\n")
+			}
+			if title := fieldTitle(field); title != "" {
+				sb.WriteString("# " + title + "\n")
+			}
+			sb.WriteString(fmt.Sprintf("# %T", field.GetValue()))
+			if o, ok := field.(optionLister); ok {
+				sb.WriteString(", one of: " + strings.Join(o.optionValues(), ", "))
+			}
+			sb.WriteString("\n")
+
+			value, err := json.Marshal(publicValue(field))
+			if err != nil {
+				value = []byte("null")
+			}
+			sb.WriteString(key + " = " + string(value) + "\n")
+		}
+	}
+	return sb.String()
+}
+
+// jsonValues returns the values of options as JSON.
+func jsonValues[T any](options []Option[T]) []string {
+	values := make([]string, 0, len(options))
+	for _, option := range options {
+		value, err := json.Marshal(option.Value)
+		if err != nil {
+			continue
+		}
+		values = append(values, string(value))
+	}
+	return values
+}
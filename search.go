@@ -0,0 +1,241 @@
+package huh
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/cursor"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// WithFieldSearch sets whether the Search binding, / by default, opens a
+// search of the titles of the form's fields, for getting around big forms.
+// Choosing a field focuses it.
+//
+// The search lists the fields of the groups that aren't hidden. Jumping ahead
+// always validates every field passed over, from the focused one, and the
+// groups left behind, focusing the first error instead if there is one, as
+// going through them would. Jumping back doesn't validate.
+//
+// In accessible mode, answering with the GoTo answer and a question number,
+// such as "goto 3", jumps to that question.
+func (f *Form) WithFieldSearch(v bool) *Form {
+	f.fieldSearch = v
+	for _, group := range f.groups {
+		group.fieldSearch = v
+	}
+	return f
+}
+
+// searchMatch is a field matching the field search, along with its position.
+type searchMatch struct {
+	field Field
+	group int
+	index int
+}
+
+// openSearch opens the field search.
+func (f *Form) openSearch() tea.Cmd {
+	f.searching = true
+	f.searchCursor = 0
+	f.searchInput = textinput.New()
+	f.searchInput.Prompt = "/"
+	f.searchInput.Placeholder = f.strings.SearchFields
+	f.searchInput.PromptStyle = f.theme.Focused.TextInput.Prompt
+	f.searchInput.TextStyle = f.theme.Focused.TextInput.Text
+	f.searchInput.PlaceholderStyle = f.theme.Focused.placeholder()
+	f.searchInput.Cursor.Style = f.theme.Focused.TextInput.Cursor
+	// The search is drawn in place of the group, which gets the blinks.
+	f.searchInput.Cursor.SetMode(cursor.CursorStatic)
+	return f.searchInput.Focus()
+}
+
+// searchMatches returns the fields whose titles contain the search query, in
+// the groups that aren't hidden. Notes and fields without titles are left out.
+func (f *Form) searchMatches() []searchMatch {
+	query := strings.ToLower(f.searchInput.Value())
+	var matches []searchMatch
+	for i, group := range f.groups {
+		if group.hide != nil && group.hide() {
+			continue
+		}
+		for _, j := range group.tabOrder() {
+			field := group.fields[j]
+			title := fieldTitle(field)
+			if _, ok := field.(*Note); ok || title == "" {
+				continue
+			}
+			if strings.Contains(strings.ToLower(title), query) {
+				matches = append(matches, searchMatch{field: field, group: i, index: j})
+			}
+		}
+	}
+	return matches
+}
+
+// updateSearch handles the keys of the open field search.
+func (f *Form) updateSearch(msg tea.KeyMsg) tea.Cmd {
+	switch {
+	case msg.Type == tea.KeyEsc:
+		f.searching = false
+		return nil
+	case msg.Type == tea.KeyEnter:
+		matches := f.searchMatches()
+		if len(matches) == 0 {
+			return nil
+		}
+		f.searching = false
+		match := matches[clamp(f.searchCursor, 0, len(matches)-1)]
+		return f.jumpTo(match.group, match.index)
+	case typedText(msg):
+	case key.Matches(msg, f.keymap.Select.Up):
+		f.searchCursor = max(f.searchCursor-1, 0)
+		return nil
+	case key.Matches(msg, f.keymap.Select.Down):
+		f.searchCursor = min(f.searchCursor+1, max(len(f.searchMatches())-1, 0))
+		return nil
+	}
+
+	query := f.searchInput.Value()
+	var cmd tea.Cmd
+	f.searchInput, cmd = f.searchInput.Update(msg)
+	if f.searchInput.Value() != query {
+		f.searchCursor = 0
+	}
+	return cmd
+}
+
+// jumpTo focuses the field at the given position. Jumping ahead validates
+// every field passed over, from the focused one, and the groups left behind,
+// focusing the first error instead if there is one. Jumping back doesn't
+// validate.
+func (f *Form) jumpTo(group, field int) tea.Cmd {
+	from := f.paginator.Page
+	f.groups[from].fields[f.groups[from].paginator.Page].Blur()
+
+	for i := from; i <= group; i++ {
+		g := f.groups[i]
+		if g.hide != nil && g.hide() {
+			continue
+		}
+		first := -1
+		passing := i != from
+		for _, j := range g.tabOrder() {
+			if i == from && j == g.paginator.Page {
+				passing = true
+			}
+			if i == group && j == field {
+				break
+			}
+			if !passing {
+				continue
+			}
+			if v, ok := g.fields[j].(validator); ok {
+				v.validateField()
+			}
+			if first < 0 && g.fields[j].Error() != nil {
+				first = j
+			}
+		}
+		if i < group && g.runValidation() != nil && first < 0 {
+			first = g.tabOrder()[0]
+		}
+		if first >= 0 {
+			group, field = i, first
+			break
+		}
+	}
+
+	f.paginator.Page = group
+	f.groups[group].paginator.Page = field
+	return f.groups[group].fields[field].Focus()
+}
+
+// searchView renders the open field search, listing the matching fields with
+// the title of their group, if any.
+func (f *Form) searchView() string {
+	styles := f.theme.Focused
+	var sb strings.Builder
+	sb.WriteString(f.searchInput.View())
+
+	matches := f.searchMatches()
+	if len(matches) == 0 {
+		sb.WriteString("\n" + styles.placeholder().Render(fmt.Sprintf(f.strings.NoMatches, f.searchInput.Value())))
+	}
+	cursor := clamp(f.searchCursor, 0, len(matches)-1)
+	selector := styles.SelectSelector.String()
+	for i, match := range matches {
+		sb.WriteString("\n")
+		style := styles.Option
+		if i == cursor {
+			sb.WriteString(selector)
+			style = styles.SelectedOption
+		} else {
+			sb.WriteString(strings.Repeat(" ", lipgloss.Width(selector)))
+		}
+		sb.WriteString(style.Render(fieldTitle(match.field)))
+		if title := f.groups[match.group].title; title != "" {
+			sb.WriteString(" " + styles.Description.Render(title))
+		}
+	}
+	return styles.Base.Render(sb.String())
+}
+
+// fieldBinds returns whether a key is bound by the focused field of the group,
+// which takes it over form bindings that give way to fields.
+func (g *Group) fieldBinds(k string) bool {
+	for _, b := range g.fields[g.paginator.Page].KeyBinds() {
+		if !b.Enabled() {
+			continue
+		}
+		for _, bound := range b.Keys() {
+			if bound == k {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// goToError is returned by accessible prompts when the user answers with the
+// GoTo answer, to jump to the question with the given number.
+type goToError struct {
+	question int
+}
+
+func (e goToError) Error() string {
+	return "go to question " + strconv.Itoa(e.question)
+}
+
+// parseGoTo returns the question number of a GoTo answer, if the input is one
+// and jumping is allowed.
+func parseGoTo(s *Strings, input string) (int, bool) {
+	if !s.allowGoTo {
+		return 0, false
+	}
+	fields := strings.Fields(input)
+	if len(fields) != 2 || !strings.EqualFold(fields[0], s.GoTo) {
+		return 0, false
+	}
+	n, err := strconv.Atoi(fields[1])
+	return n, err == nil
+}
+
+// goToQuestion returns the position of the question with the given number.
+// It stays at pos if there is no such question.
+func goToQuestion(fields []Field, pos, n int, s *Strings) int {
+	for i, field := range fields {
+		if isQuestion(field) {
+			if n--; n == 0 {
+				return i
+			}
+		}
+	}
+	fmt.Println(s.errorText(errors.New(s.InvalidInput)))
+	return pos
+}
@@ -0,0 +1,281 @@
+package huh
+
+import "strconv"
+
+// Strings are the user-facing labels rendered by fields and printed by their
+// accessible prompts.
+//
+// Forms use the default English set unless told otherwise, translate a form by
+// passing a modified copy of DefaultStrings to Form.WithStrings. The help
+// labels of keybindings are part of the KeyMap and can be translated with
+// Form.WithKeyMap.
+type Strings struct {
+	// Affirmative is the default label of a confirm's affirmative button.
+	Affirmative string
+
+	// Negative is the default label of a confirm's negative button.
+	Negative string
+
+	// Next is the label of a note's next button.
+	Next string
+
+	// Choose is the accessible prompt used to choose a select option.
+	Choose string
+
+	// Chose prefixes the accessible echo of a chosen option or answer.
+	Chose string
+
+	// Input is the accessible prompt and echo prefix of input and text fields.
+	Input string
+
+	// Select is the accessible prompt used to toggle multi-select options.
+	Select string
+
+	// SelectLimit explains how to use an accessible multi-select. It is
+	// formatted with the multi-select's limit.
+	SelectLimit string
+
+	// Selected prefixes accessible multi-select selections.
+	Selected string
+
+	// Deselected prefixes accessible multi-select deselections.
+	Deselected string
+
+	// SelectAll and SelectNone are the accessible multi-select answers that
+	// select all options, up to the limit, and clear them.
+	SelectAll  string
+	SelectNone string
+
+	// Default tags the default option of a select.
+	Default string
+
+	// ConfirmPrompt is the accessible prompt of a confirm field.
+	ConfirmPrompt string
+
+	// ConfirmOption is the question asked before moving on from a select
+	// option with a confirm message, which it is formatted with.
+	ConfirmOption string
+
+	// ConfirmPhrase is the accessible prompt of a type-to-confirm field. It
+	// is formatted with the phrase to type.
+	ConfirmPhrase string
+
+	// PhraseMismatch is printed when an accessible type-to-confirm answer
+	// doesn't match the phrase.
+	PhraseMismatch string
+
+	// Yes are the accepted affirmative answers to an accessible confirm.
+	Yes []string
+
+	// No are the accepted negative answers to an accessible confirm.
+	No []string
+
+	// ErrorPrefix marks the errors printed by accessible prompts, so that
+	// screen readers announce them as errors.
+	ErrorPrefix string
+
+	// InvalidInput is printed when an accessible answer can't be understood.
+	InvalidInput string
+
+	// OutOfRange is the error of a slider value outside of its range. It is
+	// formatted with the minimum and the maximum, along with the unit.
+	OutOfRange string
+
+	// NumberPrompt is the accessible prompt of a slider field. It is
+	// formatted with the minimum and the maximum, along with the unit.
+	NumberPrompt string
+
+	// Required is the error of a required field that was left empty.
+	Required string
+
+	// MissingRequired heads the list of required fields that are still empty
+	// when submitting, see Form.WithRequiredSummary.
+	MissingRequired string
+
+	// Strength are the labels of the scores of an input's strength meter,
+	// from 0 to 4.
+	Strength []string
+
+	// StrengthPrefix prefixes the strength label printed after an accessible
+	// input with a strength meter.
+	StrengthPrefix string
+
+	// Question numbers the questions of an accessible form. It is formatted
+	// with the position of the question and the number of questions.
+	Question string
+
+	// Back is the answer that returns to the previous question of an
+	// accessible form.
+	Back string
+
+	// Quit is the answer that aborts an accessible form.
+	Quit string
+
+	// ConfirmQuit is the accessible prompt confirming the Quit answer, see
+	// Form.WithAccessibleAbortConfirm.
+	ConfirmQuit string
+
+	// GoTo is the answer that jumps to the question numbered after it, such
+	// as "goto 3", in an accessible form with field search, see
+	// Form.WithFieldSearch.
+	GoTo string
+
+	// FirstQuestion is printed when going back from the first question of an
+	// accessible form.
+	FirstQuestion string
+
+	// Summary is the title of the answers printed at the end of an
+	// accessible form.
+	Summary string
+
+	// Submit is the accessible prompt to submit a form after its summary.
+	Submit string
+
+	// NoOptions is shown in place of the options of a select without any.
+	NoOptions string
+
+	// NoMatches is shown in place of the options of a select when none match
+	// its filter, which it is formatted with, and in a field search without
+	// matching fields.
+	NoMatches string
+
+	// SearchFields is the placeholder of the field search of a form, see
+	// Form.WithFieldSearch.
+	SearchFields string
+
+	// FilterCount is the count of the options of a select matching its filter
+	// while filtering, formatted with the number of matches and of options.
+	FilterCount string
+
+	// SubmitButton is the label of the default button of a button field.
+	SubmitButton string
+
+	// Up is the accessible option returning to the parent level of a tree.
+	Up string
+
+	// SubmittingIn and ClosingIn count down the seconds until an idle form
+	// is submitted or aborted.
+	SubmittingIn string
+	ClosingIn    string
+
+	// Current is the footer showing the committed value of the focused field
+	// of a form with value footers, which it is formatted with.
+	Current string
+
+	// Copied confirms that the value of a field was copied to the clipboard.
+	Copied string
+
+	// HelpNavigation, HelpEditing and HelpForm title the sections of grouped
+	// help.
+	HelpNavigation string
+	HelpEditing    string
+	HelpForm       string
+
+	// Enumerator labels the options of accessible lists given their index.
+	// Answers to accessible lists may use either numbers or letters,
+	// regardless of the enumerator.
+	Enumerator func(index int) string
+
+	// OptionFormat formats an option of an accessible list given its label,
+	// as returned by the Enumerator, and its text. Swap the order for
+	// right-to-left languages.
+	OptionFormat func(label, text string) string
+
+	// allowBack is whether accessible prompts accept the Back and Quit
+	// answers, which is only the case while a form runs them in order.
+	allowBack bool
+
+	// allowGoTo is whether accessible prompts accept the GoTo answer, which
+	// forms with field search allow along with going back.
+	allowGoTo bool
+
+	// question is the title of the question being asked by a form in
+	// accessible mode, repeated after errors so that the answer has context.
+	question string
+
+	// bell is whether accessible errors ring the terminal bell.
+	bell bool
+
+	// interrupted is set to 1 while the answer being read is the one to
+	// ConfirmQuit, after an interrupt such as ctrl+c, if the form asks for
+	// confirmation of quitting.
+	interrupted *int32
+}
+
+// DefaultStrings returns the default English strings.
+func DefaultStrings() *Strings {
+	return &Strings{
+		Affirmative:     "Yes",
+		Negative:        "No",
+		Next:            "Next",
+		Choose:          "Choose: ",
+		Chose:           "Chose: ",
+		Input:           "Input: ",
+		Select:          "Select: ",
+		SelectLimit:     "Select up to %d options. 0 to continue.",
+		Selected:        "Selected: ",
+		Deselected:      "Deselected: ",
+		SelectAll:       "all",
+		SelectNone:      "none",
+		Default:         "(default)",
+		ConfirmPrompt:   "Choose [y/N]: ",
+		ConfirmOption:   "%s [y/N]",
+		ConfirmPhrase:   "Type %q to confirm, or nothing to cancel: ",
+		PhraseMismatch:  "the phrase does not match",
+		Yes:             []string{"y", "yes"},
+		No:              []string{"n", "no"},
+		ErrorPrefix:     "Error: ",
+		InvalidInput:    "invalid input. please try again",
+		OutOfRange:      "must be between %s and %s",
+		NumberPrompt:    "Enter a number from %s to %s: ",
+		Required:        "this field is required",
+		MissingRequired: "Fill in the required fields:",
+		Strength:        []string{"Very weak", "Weak", "Fair", "Good", "Strong"},
+		StrengthPrefix:  "Strength: ",
+		Question:        "Question %d of %d",
+		Back:            "back",
+		Quit:            "quit",
+		ConfirmQuit:     "Are you sure you want to quit? [y/N]: ",
+		GoTo:            "goto",
+		FirstQuestion:   "already at the first question",
+		Summary:         "Summary",
+		Submit:          "Submit? [y/N]: ",
+		NoOptions:       "No options",
+		NoMatches:       "No matches for '%s'",
+		SearchFields:    "Search fields",
+		FilterCount:     "showing %d of %d",
+		SubmitButton:    "Submit",
+		Up:              "Up one level",
+		SubmittingIn:    "Submitting in %ds",
+		ClosingIn:       "Closing in %ds",
+		Current:         "Current: %s",
+		Copied:          "Copied!",
+		HelpNavigation:  "Navigation",
+		HelpEditing:     "Editing",
+		HelpForm:        "Form",
+		Enumerator:      NumberEnumerator,
+		OptionFormat: func(label, text string) string {
+			return label + ". " + text
+		},
+	}
+}
+
+// formatOption formats an option of an accessible list.
+func (s *Strings) formatOption(index int, text string) string {
+	return s.OptionFormat(s.Enumerator(index), text)
+}
+
+// NumberEnumerator labels accessible options with numbers, starting at 1.
+func NumberEnumerator(index int) string {
+	return strconv.Itoa(index + 1)
+}
+
+// LetterEnumerator labels accessible options with letters, starting at a.
+// Options beyond the end of the alphabet fall back to numbers, which are
+// equivalent to the letters' positions.
+func LetterEnumerator(index int) string {
+	if index < 0 || index >= 26 {
+		return NumberEnumerator(index)
+	}
+	return string(rune('a' + index))
+}
@@ -1,6 +1,8 @@
 package huh
 
 import (
+	"reflect"
+
 	catppuccin "github.com/catppuccin/go"
 	"github.com/charmbracelet/bubbles/help"
 	"github.com/charmbracelet/lipgloss"
@@ -12,9 +14,22 @@ type Theme struct {
 	Form           lipgloss.Style
 	Group          lipgloss.Style
 	FieldSeparator lipgloss.Style
+	Countdown      lipgloss.Style
+	Copied         lipgloss.Style
+	Changed        lipgloss.Style
+	Missing        lipgloss.Style
 	Blurred        FieldStyles
 	Focused        FieldStyles
-	Help           help.Styles
+
+	// Error styles fields with an error, whether they are focused or not.
+	// Themes that leave it unset style them as Focused or Blurred.
+	Error FieldStyles
+
+	// Disabled styles fields that can't be used. None of the built-in fields
+	// can be disabled, it's there for custom fields to use.
+	Disabled FieldStyles
+
+	Help help.Styles
 }
 
 // copy returns a copy of a theme with all children styles copied.
@@ -23,8 +38,14 @@ func (t Theme) copy() Theme {
 		Form:           t.Form.Copy(),
 		Group:          t.Group.Copy(),
 		FieldSeparator: t.FieldSeparator.Copy(),
+		Countdown:      t.Countdown.Copy(),
+		Copied:         t.Copied.Copy(),
+		Changed:        t.Changed.Copy(),
+		Missing:        t.Missing.Copy(),
 		Blurred:        t.Blurred.copy(),
 		Focused:        t.Focused.copy(),
+		Error:          t.Error.copy(),
+		Disabled:       t.Disabled.copy(),
 		Help: help.Styles{
 			Ellipsis:       t.Help.Ellipsis.Copy(),
 			ShortKey:       t.Help.ShortKey.Copy(),
@@ -45,9 +66,22 @@ type FieldStyles struct {
 	ErrorIndicator lipgloss.Style
 	ErrorMessage   lipgloss.Style
 
+	// Warning styles.
+	WarningIndicator lipgloss.Style
+	WarningMessage   lipgloss.Style
+
 	// Select styles.
 	SelectSelector lipgloss.Style // Selection indicator
 	Option         lipgloss.Style // Select options
+	OptionNumber   lipgloss.Style // Numbers of numbered select options
+	Accelerator    lipgloss.Style // Accelerator keys of select options
+	DefaultOption  lipgloss.Style // Default option tag
+	Tooltip        lipgloss.Style // Option description tooltip
+	Breadcrumb     lipgloss.Style // Path to the open level of a tree
+	ScrollbarTrack lipgloss.Style // Scrollbar of scrolling options
+	ScrollbarThumb lipgloss.Style // Position of the visible options
+	MoreAbove      lipgloss.Style // Indicator of options above the visible ones
+	MoreBelow      lipgloss.Style // Indicator of options below the visible ones
 
 	// Multi-select styles.
 	MultiSelectSelector lipgloss.Style
@@ -56,9 +90,22 @@ type FieldStyles struct {
 	UnselectedOption    lipgloss.Style
 	UnselectedPrefix    lipgloss.Style
 
+	// Slider styles.
+	SliderTrack lipgloss.Style
+	SliderThumb lipgloss.Style
+
 	// Textinput and teatarea styles.
 	TextInput TextInputStyles
 
+	// Placeholders and empty option lists, drawn with the TextInput
+	// Placeholder style where this one leaves a property unset.
+	Placeholder lipgloss.Style
+
+	// Strength meter styles.
+	StrengthWeak   lipgloss.Style
+	StrengthFair   lipgloss.Style
+	StrengthStrong lipgloss.Style
+
 	// Confirm styles.
 	FocusedButton lipgloss.Style
 	BlurredButton lipgloss.Style
@@ -92,23 +139,101 @@ func (f FieldStyles) copy() FieldStyles {
 		Base:                f.Base.Copy(),
 		Title:               f.Title.Copy(),
 		Description:         f.Description.Copy(),
+		Placeholder:         f.Placeholder.Copy(),
 		ErrorIndicator:      f.ErrorIndicator.Copy(),
 		ErrorMessage:        f.ErrorMessage.Copy(),
+		WarningIndicator:    f.WarningIndicator.Copy(),
+		WarningMessage:      f.WarningMessage.Copy(),
 		SelectSelector:      f.SelectSelector.Copy(),
 		Option:              f.Option.Copy(),
+		OptionNumber:        f.OptionNumber.Copy(),
+		Accelerator:         f.Accelerator.Copy(),
+		DefaultOption:       f.DefaultOption.Copy(),
+		Tooltip:             f.Tooltip.Copy(),
+		Breadcrumb:          f.Breadcrumb.Copy(),
+		ScrollbarTrack:      f.ScrollbarTrack.Copy(),
+		ScrollbarThumb:      f.ScrollbarThumb.Copy(),
+		MoreAbove:           f.MoreAbove.Copy(),
+		MoreBelow:           f.MoreBelow.Copy(),
 		MultiSelectSelector: f.MultiSelectSelector.Copy(),
 		SelectedOption:      f.SelectedOption.Copy(),
 		SelectedPrefix:      f.SelectedPrefix.Copy(),
 		UnselectedOption:    f.UnselectedOption.Copy(),
 		UnselectedPrefix:    f.UnselectedPrefix.Copy(),
+		SliderTrack:         f.SliderTrack.Copy(),
+		SliderThumb:         f.SliderThumb.Copy(),
 		FocusedButton:       f.FocusedButton.Copy(),
 		BlurredButton:       f.BlurredButton.Copy(),
 		TextInput:           f.TextInput.copy(),
+		StrengthWeak:        f.StrengthWeak.Copy(),
+		StrengthFair:        f.StrengthFair.Copy(),
+		StrengthStrong:      f.StrengthStrong.Copy(),
 		Card:                f.Card.Copy(),
 		Next:                f.Next.Copy(),
 	}
 }
 
+// minimal returns a copy of a theme without the borders and padding of the
+// fields, for the minimal layout.
+func (t Theme) minimal() *Theme {
+	m := t.copy()
+	m.Focused.Base = lipgloss.NewStyle()
+	m.Blurred.Base = lipgloss.NewStyle()
+	m.Error.Base = lipgloss.NewStyle()
+	m.Disabled.Base = lipgloss.NewStyle()
+	return &m
+}
+
+// fieldStyles returns the styles of a field in the given state: the Error
+// styles when it has an error and the theme sets them, or else the Focused or
+// Blurred styles.
+func (t *Theme) fieldStyles(focused bool, err error) FieldStyles {
+	switch {
+	case err != nil && !reflect.ValueOf(t.Error).IsZero():
+		return t.Error
+	case focused:
+		return t.Focused
+	}
+	return t.Blurred
+}
+
+// placeholder returns the style of placeholders, the Placeholder style over
+// the TextInput.Placeholder style, which themes color placeholders with.
+func (f FieldStyles) placeholder() lipgloss.Style {
+	return f.Placeholder.Copy().Inherit(f.TextInput.Placeholder)
+}
+
+// errorStyles returns Error styles based on the given styles, with the border
+// and title colored with the given color.
+func errorStyles(styles FieldStyles, color lipgloss.TerminalColor) FieldStyles {
+	s := styles.copy()
+	s.Base.BorderForeground(color)
+	s.Title.Foreground(color)
+	return s
+}
+
+// disabledStyles returns Disabled styles based on the given styles, with the
+// text faint.
+func disabledStyles(styles FieldStyles) FieldStyles {
+	s := styles.copy()
+	s.Title.Faint(true)
+	s.Description.Faint(true)
+	s.Option.Faint(true)
+	s.SelectedOption.Faint(true)
+	s.UnselectedOption.Faint(true)
+	s.TextInput.Text.Faint(true)
+	return s
+}
+
+// fieldBase returns the Base style of the given field styles, or an empty
+// style for fields without padding.
+func fieldBase(styles FieldStyles, noPadding bool) lipgloss.Style {
+	if noPadding {
+		return lipgloss.NewStyle()
+	}
+	return styles.Base
+}
+
 const (
 	buttonPaddingHorizontal = 2
 	buttonPaddingVertical   = 0
@@ -135,8 +260,29 @@ func ThemeBase() *Theme {
 		SetString(" *")
 	f.ErrorMessage = lipgloss.NewStyle().
 		SetString(" *")
+	f.WarningIndicator = lipgloss.NewStyle().
+		SetString(" !")
+	f.WarningMessage = lipgloss.NewStyle().
+		SetString(" !")
 	f.SelectSelector = lipgloss.NewStyle().
 		SetString("> ")
+	f.Tooltip = lipgloss.NewStyle().
+		BorderStyle(lipgloss.RoundedBorder()).
+		Padding(0, 1)
+	f.Accelerator = lipgloss.NewStyle().
+		Underline(true)
+	f.ScrollbarTrack = lipgloss.NewStyle().
+		SetString("│")
+	f.ScrollbarThumb = lipgloss.NewStyle().
+		SetString("┃")
+	f.MoreAbove = lipgloss.NewStyle().
+		SetString("↑")
+	f.MoreBelow = lipgloss.NewStyle().
+		SetString("↓")
+	f.SliderTrack = lipgloss.NewStyle().
+		SetString("─")
+	f.SliderThumb = lipgloss.NewStyle().
+		SetString("●")
 	f.MultiSelectSelector = lipgloss.NewStyle().
 		SetString("> ")
 	f.SelectedPrefix = lipgloss.NewStyle().
@@ -150,7 +296,12 @@ func ThemeBase() *Theme {
 		Foreground(lipgloss.Color("7")).
 		Background(lipgloss.Color("0"))
 	f.TextInput.Placeholder = lipgloss.NewStyle().Foreground(lipgloss.Color("8"))
+	f.Placeholder = lipgloss.NewStyle().Italic(true)
 
+	t.Changed = lipgloss.NewStyle().Bold(true)
+	t.Missing = lipgloss.NewStyle().
+		BorderStyle(lipgloss.RoundedBorder()).
+		Padding(0, 1)
 	t.Help = help.New().Styles
 
 	// Blurred styles.
@@ -158,6 +309,9 @@ func ThemeBase() *Theme {
 	t.Blurred.Base = t.Blurred.Base.BorderStyle(lipgloss.HiddenBorder())
 	t.Blurred.MultiSelectSelector = lipgloss.NewStyle().SetString("  ")
 
+	t.Error = errorStyles(t.Focused, lipgloss.Color("9"))
+	t.Disabled = disabledStyles(t.Blurred)
+
 	return &t
 }
 
@@ -172,6 +326,7 @@ func ThemeCharm() *Theme {
 		fuchsia  = lipgloss.Color("#F780E2")
 		green    = lipgloss.AdaptiveColor{Light: "#02BA84", Dark: "#02BF87"}
 		red      = lipgloss.AdaptiveColor{Light: "#FF4672", Dark: "#ED567A"}
+		yellow   = lipgloss.AdaptiveColor{Light: "#D9A400", Dark: "#FFD866"}
 	)
 
 	f := &t.Focused
@@ -180,9 +335,20 @@ func ThemeCharm() *Theme {
 	f.Description.Foreground(lipgloss.AdaptiveColor{Light: "", Dark: "243"})
 	f.ErrorIndicator.Foreground(red)
 	f.ErrorMessage.Foreground(red)
+	f.WarningIndicator.Foreground(yellow)
+	f.WarningMessage.Foreground(yellow)
 	f.SelectSelector.Foreground(fuchsia)
 	f.Option.Foreground(normalFg)
+	f.OptionNumber.Foreground(lipgloss.AdaptiveColor{Light: "", Dark: "243"})
+	f.DefaultOption.Foreground(lipgloss.AdaptiveColor{Light: "", Dark: "243"})
+	f.Tooltip.BorderForeground(indigo).Foreground(normalFg)
+	f.Breadcrumb.Foreground(lipgloss.AdaptiveColor{Light: "", Dark: "243"})
+	f.ScrollbarTrack.Foreground(lipgloss.AdaptiveColor{Light: "252", Dark: "237"})
+	f.ScrollbarThumb.Foreground(fuchsia)
+	f.MoreAbove.Foreground(fuchsia)
+	f.MoreBelow.Foreground(fuchsia)
 	f.MultiSelectSelector.Foreground(fuchsia)
+	f.SliderThumb.Foreground(fuchsia)
 	f.SelectedOption.Foreground(green)
 	f.SelectedPrefix = lipgloss.NewStyle().Foreground(lipgloss.AdaptiveColor{Light: "#02CF92", Dark: "#02A877"}).SetString("✓ ")
 	f.UnselectedPrefix = lipgloss.NewStyle().Foreground(lipgloss.AdaptiveColor{Light: "", Dark: "243"}).SetString("• ")
@@ -195,9 +361,21 @@ func ThemeCharm() *Theme {
 	f.TextInput.Placeholder.Foreground(lipgloss.AdaptiveColor{Light: "248", Dark: "238"})
 	f.TextInput.Prompt.Foreground(fuchsia)
 
+	f.StrengthWeak.Foreground(red)
+	f.StrengthFair.Foreground(yellow)
+	f.StrengthStrong.Foreground(green)
+
 	t.Blurred = f.copy()
 	t.Blurred.Base.BorderStyle(lipgloss.HiddenBorder())
 
+	t.Error = errorStyles(t.Focused, red)
+	t.Disabled = disabledStyles(t.Blurred)
+
+	t.Countdown.Foreground(yellow)
+	t.Copied.Foreground(green)
+	t.Changed.Foreground(yellow)
+	t.Missing.BorderForeground(red)
+
 	return &t
 }
 
@@ -213,6 +391,7 @@ func ThemeDracula() *Theme {
 		green      = lipgloss.AdaptiveColor{Dark: "#50fa7b"}
 		purple     = lipgloss.AdaptiveColor{Dark: "#bd93f9"}
 		red        = lipgloss.AdaptiveColor{Dark: "#ff5555"}
+		orange     = lipgloss.AdaptiveColor{Dark: "#ffb86c"}
 		yellow     = lipgloss.AdaptiveColor{Dark: "#f1fa8c"}
 	)
 
@@ -222,9 +401,20 @@ func ThemeDracula() *Theme {
 	f.Description.Foreground(comment)
 	f.ErrorIndicator.Foreground(red)
 	f.ErrorMessage.Foreground(red)
+	f.WarningIndicator.Foreground(orange)
+	f.WarningMessage.Foreground(orange)
 	f.SelectSelector.Foreground(yellow)
 	f.Option.Foreground(foreground)
+	f.OptionNumber.Foreground(comment)
+	f.DefaultOption.Foreground(comment)
+	f.Tooltip.BorderForeground(purple).Foreground(foreground)
+	f.Breadcrumb.Foreground(comment)
+	f.ScrollbarTrack.Foreground(selection)
+	f.ScrollbarThumb.Foreground(purple)
+	f.MoreAbove.Foreground(purple)
+	f.MoreBelow.Foreground(purple)
 	f.MultiSelectSelector.Foreground(yellow)
+	f.SliderThumb.Foreground(yellow)
 	f.SelectedOption.Foreground(green)
 	f.SelectedPrefix.Foreground(green)
 	f.UnselectedOption.Foreground(foreground)
@@ -236,9 +426,21 @@ func ThemeDracula() *Theme {
 	f.TextInput.Placeholder.Foreground(comment)
 	f.TextInput.Prompt.Foreground(yellow)
 
+	f.StrengthWeak.Foreground(red)
+	f.StrengthFair.Foreground(orange)
+	f.StrengthStrong.Foreground(green)
+
 	t.Blurred = f.copy()
 	t.Blurred.Base = t.Blurred.Base.BorderStyle(lipgloss.HiddenBorder())
 
+	t.Error = errorStyles(t.Focused, red)
+	t.Disabled = disabledStyles(t.Blurred)
+
+	t.Countdown.Foreground(orange)
+	t.Copied.Foreground(green)
+	t.Changed.Foreground(orange)
+	t.Missing.BorderForeground(red)
+
 	return &t
 }
 
@@ -252,9 +454,20 @@ func ThemeBase16() *Theme {
 	f.Description.Foreground(lipgloss.Color("8"))
 	f.ErrorIndicator.Foreground(lipgloss.Color("9"))
 	f.ErrorMessage.Foreground(lipgloss.Color("9"))
+	f.WarningIndicator.Foreground(lipgloss.Color("3"))
+	f.WarningMessage.Foreground(lipgloss.Color("3"))
 	f.SelectSelector.Foreground(lipgloss.Color("3"))
 	f.Option.Foreground(lipgloss.Color("7"))
+	f.OptionNumber.Foreground(lipgloss.Color("8"))
+	f.DefaultOption.Foreground(lipgloss.Color("8"))
+	f.Tooltip.BorderForeground(lipgloss.Color("6")).Foreground(lipgloss.Color("7"))
+	f.Breadcrumb.Foreground(lipgloss.Color("8"))
+	f.ScrollbarTrack.Foreground(lipgloss.Color("8"))
+	f.ScrollbarThumb.Foreground(lipgloss.Color("3"))
+	f.MoreAbove.Foreground(lipgloss.Color("3"))
+	f.MoreBelow.Foreground(lipgloss.Color("3"))
 	f.MultiSelectSelector.Foreground(lipgloss.Color("3"))
+	f.SliderThumb.Foreground(lipgloss.Color("3"))
 	f.SelectedOption.Foreground(lipgloss.Color("2"))
 	f.SelectedPrefix.Foreground(lipgloss.Color("2"))
 	f.UnselectedOption.Foreground(lipgloss.Color("7"))
@@ -265,12 +478,24 @@ func ThemeBase16() *Theme {
 	f.TextInput.Placeholder.Foreground(lipgloss.Color("8"))
 	f.TextInput.Prompt.Foreground(lipgloss.Color("3"))
 
+	f.StrengthWeak.Foreground(lipgloss.Color("9"))
+	f.StrengthFair.Foreground(lipgloss.Color("3"))
+	f.StrengthStrong.Foreground(lipgloss.Color("2"))
+
 	t.Blurred = f.copy()
 	t.Blurred.Base = t.Blurred.Base.BorderStyle(lipgloss.HiddenBorder())
 	t.Blurred.Title.Foreground(lipgloss.Color("8"))
 	t.Blurred.TextInput.Prompt.Foreground(lipgloss.Color("8"))
 	t.Blurred.TextInput.Text.Foreground(lipgloss.Color("7"))
 
+	t.Error = errorStyles(t.Focused, lipgloss.Color("9"))
+	t.Disabled = disabledStyles(t.Blurred)
+
+	t.Countdown.Foreground(lipgloss.Color("3"))
+	t.Copied.Foreground(lipgloss.Color("2"))
+	t.Changed.Foreground(lipgloss.Color("3"))
+	t.Missing.BorderForeground(lipgloss.Color("9"))
+
 	return &t
 }
 
@@ -289,6 +514,7 @@ func ThemeCatppuccin() *Theme {
 		overlay0 = lipgloss.AdaptiveColor{Light: light.Overlay0().Hex, Dark: dark.Overlay0().Hex}
 		green    = lipgloss.AdaptiveColor{Light: light.Green().Hex, Dark: dark.Green().Hex}
 		red      = lipgloss.AdaptiveColor{Light: light.Red().Hex, Dark: dark.Red().Hex}
+		peach    = lipgloss.AdaptiveColor{Light: light.Peach().Hex, Dark: dark.Peach().Hex}
 		pink     = lipgloss.AdaptiveColor{Light: light.Pink().Hex, Dark: dark.Pink().Hex}
 		mauve    = lipgloss.AdaptiveColor{Light: light.Mauve().Hex, Dark: dark.Mauve().Hex}
 		cursor   = lipgloss.AdaptiveColor{Light: light.Rosewater().Hex, Dark: dark.Rosewater().Hex}
@@ -300,9 +526,20 @@ func ThemeCatppuccin() *Theme {
 	f.Description.Foreground(subtext0)
 	f.ErrorIndicator.Foreground(red)
 	f.ErrorMessage.Foreground(red)
+	f.WarningIndicator.Foreground(peach)
+	f.WarningMessage.Foreground(peach)
 	f.SelectSelector.Foreground(pink)
 	f.Option.Foreground(text)
+	f.OptionNumber.Foreground(overlay1)
+	f.DefaultOption.Foreground(overlay1)
+	f.Tooltip.BorderForeground(mauve).Foreground(text)
+	f.Breadcrumb.Foreground(subtext0)
+	f.ScrollbarTrack.Foreground(overlay0)
+	f.ScrollbarThumb.Foreground(pink)
+	f.MoreAbove.Foreground(pink)
+	f.MoreBelow.Foreground(pink)
 	f.MultiSelectSelector.Foreground(pink)
+	f.SliderThumb.Foreground(pink)
 	f.SelectedOption.Foreground(green)
 	f.SelectedPrefix.Foreground(green)
 	f.UnselectedPrefix.Foreground(text)
@@ -314,9 +551,21 @@ func ThemeCatppuccin() *Theme {
 	f.TextInput.Placeholder.Foreground(overlay0)
 	f.TextInput.Prompt.Foreground(pink)
 
+	f.StrengthWeak.Foreground(red)
+	f.StrengthFair.Foreground(peach)
+	f.StrengthStrong.Foreground(green)
+
 	t.Blurred = f.copy()
 	t.Blurred.Base.BorderStyle(lipgloss.HiddenBorder())
 
+	t.Error = errorStyles(t.Focused, red)
+	t.Disabled = disabledStyles(t.Blurred)
+
+	t.Countdown.Foreground(peach)
+	t.Copied.Foreground(green)
+	t.Changed.Foreground(peach)
+	t.Missing.BorderForeground(red)
+
 	t.Help.Ellipsis.Foreground(subtext0)
 	t.Help.ShortKey.Foreground(subtext0)
 	t.Help.ShortDesc.Foreground(overlay1)
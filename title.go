@@ -0,0 +1,89 @@
+package huh
+
+import "reflect"
+
+// titleFunc is a title computed by a function, recomputed only when the value
+// its bindings point to changes rather than on every render.
+type titleFunc struct {
+	f        func() string
+	bindings any
+	bound    any
+	computed bool
+}
+
+// update sets title to the result of the function if the value of the
+// bindings changed since it was last computed.
+func (t *titleFunc) update(title *string) {
+	if t.f == nil {
+		return
+	}
+	value := bindingValue(t.bindings)
+	if t.computed && reflect.DeepEqual(value, t.bound) {
+		return
+	}
+	t.bound, t.computed = value, true
+	*title = t.f()
+}
+
+// bindingValue returns a copy of the value bindings points to, or of bindings
+// itself if it isn't a pointer. The copy is kept to compare with, so that maps
+// and slices changed in place are noticed.
+func bindingValue(bindings any) any {
+	v := reflect.ValueOf(bindings)
+	if v.Kind() == reflect.Pointer && !v.IsNil() {
+		v = v.Elem()
+	}
+	if !v.IsValid() {
+		return nil
+	}
+	return copyValue(v).Interface()
+}
+
+// copyValue returns a copy of a value that shares none of its maps, slices
+// and arrays, and of those of its exported struct fields. Pointers are kept,
+// so values changed through them aren't noticed.
+func copyValue(v reflect.Value) reflect.Value {
+	switch v.Kind() {
+	case reflect.Map:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.MakeMapWithSize(v.Type(), v.Len())
+		for iter := v.MapRange(); iter.Next(); {
+			c.SetMapIndex(iter.Key(), copyValue(iter.Value()))
+		}
+		return c
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			c.Index(i).Set(copyValue(v.Index(i)))
+		}
+		return c
+	case reflect.Array:
+		c := reflect.New(v.Type()).Elem()
+		for i := 0; i < v.Len(); i++ {
+			c.Index(i).Set(copyValue(v.Index(i)))
+		}
+		return c
+	case reflect.Struct:
+		c := reflect.New(v.Type()).Elem()
+		c.Set(v)
+		for i := 0; i < v.NumField(); i++ {
+			if c.Field(i).CanSet() {
+				c.Field(i).Set(copyValue(v.Field(i)))
+			}
+		}
+		return c
+	case reflect.Interface:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.New(v.Type()).Elem()
+		c.Set(copyValue(v.Elem()))
+		return c
+	}
+	return v
+}
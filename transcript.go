@@ -0,0 +1,35 @@
+package huh
+
+import (
+	"io"
+	"strings"
+)
+
+// WithTranscript sets a writer that the transcript of an accessible run of
+// the form is written to once it's completed, for logging and auditing.
+//
+// The transcript has a "Title: answer" line for each answer given, in the
+// order they were given, so answers changed by going back appear again.
+// Secret values are redacted.
+func (f *Form) WithTranscript(w io.Writer) *Form {
+	f.transcript = w
+	return f
+}
+
+// record adds the answer of a field to the transcript.
+func (f *Form) record(field Field) {
+	if f.transcript == nil || !isQuestion(field) {
+		return
+	}
+	f.transcriptLines = append(f.transcriptLines, fieldTitle(field)+": "+displayValue(field))
+}
+
+// flushTranscript writes the transcript. Failing to write it doesn't fail
+// the form.
+func (f *Form) flushTranscript() {
+	if f.transcript == nil || len(f.transcriptLines) <= 0 {
+		return
+	}
+	_, _ = io.WriteString(f.transcript, strings.Join(f.transcriptLines, "\n")+"\n")
+	f.transcriptLines = nil
+}
@@ -0,0 +1,201 @@
+package huh
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// valueSetter is implemented by fields whose value can be set by the form,
+// such as to restore values with Form.Load.
+type valueSetter interface {
+	setValue(value any) error
+}
+
+// Load sets the values of the fields with keys, by key, such as to restore a
+// draft saved with WithAutosave. Values may be of a different but convertible
+// type, such as float64 for an int field after a round trip through JSON.
+//
+// Keys without a field are ignored. Loading stops at the first value that
+// can't be converted to the type of its field.
+func (f *Form) Load(values map[string]any) error {
+	for _, group := range f.groups {
+		for _, field := range group.fields {
+			value, ok := values[field.GetKey()]
+			setter, settable := field.(valueSetter)
+			if !ok || !settable {
+				continue
+			}
+			if err := setter.setValue(value); err != nil {
+				return fmt.Errorf("%s: %w", field.GetKey(), err)
+			}
+			f.results[field.GetKey()] = field.GetValue()
+		}
+	}
+	return nil
+}
+
+// Scan copies the values of the fields with keys into the struct that dest
+// points to, much like json.Unmarshal. Struct fields are matched to keys by
+// their huh tag, such as `huh:"name"`, or else by their name, ignoring case. A
+// tag of "-" leaves a struct field out, and the fields of embedded structs are
+// matched as if they were the struct's own.
+//
+// Values are converted as with Load, and unlike with Values, secrets aren't
+// redacted. Fields in hidden groups and keys without a struct field are
+// ignored. Scanning stops at the first value that can't be converted to the
+// type of its struct field.
+func (f *Form) Scan(dest any) error {
+	v := reflect.ValueOf(dest)
+	if v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("huh: Scan needs a pointer to a struct, got %T", dest)
+	}
+
+	values := make(map[string]any)
+	for _, group := range f.groups {
+		if group.hide != nil && group.hide() {
+			continue
+		}
+		for _, field := range group.fields {
+			if key := field.GetKey(); key != "" {
+				values[key] = field.GetValue()
+			}
+		}
+	}
+	return scanStruct(v.Elem(), values)
+}
+
+// scanStruct sets the fields of a struct from values by key, see Form.Scan.
+func scanStruct(v reflect.Value, values map[string]any) error {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		tag, tagged := sf.Tag.Lookup("huh")
+		if tag == "-" {
+			continue
+		}
+		if sf.Anonymous && !tagged && indirectType(sf.Type).Kind() == reflect.Struct {
+			// Pointers to unexported structs can't be allocated.
+			if sf.Type.Kind() == reflect.Pointer && !sf.IsExported() {
+				continue
+			}
+			if err := scanStruct(settable(v.Field(i)), values); err != nil {
+				return err
+			}
+			continue
+		}
+		if !sf.IsExported() {
+			continue
+		}
+
+		key, value, ok := lookupKey(values, sf.Name, tag)
+		if !ok {
+			continue
+		}
+		fv := v.Field(i)
+		if fv.Kind() == reflect.Pointer {
+			fv = settable(fv)
+		}
+		converted, err := convertValue(reflect.ValueOf(value), fv.Type())
+		if err != nil {
+			return fmt.Errorf("%s: %s.%s: %w", key, t.Name(), sf.Name, err)
+		}
+		fv.Set(converted)
+	}
+	return nil
+}
+
+// lookupKey returns the value with the given tag as its key, or without a tag
+// the value whose key equals the name, ignoring case.
+func lookupKey(values map[string]any, name, tag string) (string, any, bool) {
+	if tag != "" {
+		value, ok := values[tag]
+		return tag, value, ok
+	}
+	if value, ok := values[name]; ok {
+		return name, value, true
+	}
+	for key, value := range values {
+		if strings.EqualFold(key, name) {
+			return key, value, true
+		}
+	}
+	return "", nil, false
+}
+
+// indirectType returns the type a pointer type points to, or the type itself.
+func indirectType(t reflect.Type) reflect.Type {
+	if t.Kind() == reflect.Pointer {
+		return t.Elem()
+	}
+	return t
+}
+
+// settable returns the value a pointer points to, allocating it if it's nil,
+// or the value itself.
+func settable(v reflect.Value) reflect.Value {
+	if v.Kind() != reflect.Pointer {
+		return v
+	}
+	if v.IsNil() {
+		v.Set(reflect.New(v.Type().Elem()))
+	}
+	return v.Elem()
+}
+
+// WithAutosave sets a function that is called with the values of the fields
+// with keys in the completed groups whenever a group is completed, so that
+// progress can be saved as a draft and restored with Load.
+//
+// The function is only called once the group passes validation.
+func (f *Form) WithAutosave(autosave func(values map[string]any)) *Form {
+	f.autosave = autosave
+	return f
+}
+
+// convert converts a value to the type T of the value of a field.
+func convert[T any](value any) (T, error) {
+	var t T
+	if v, ok := value.(T); ok {
+		return v, nil
+	}
+	v, err := convertValue(reflect.ValueOf(value), reflect.TypeOf(&t).Elem())
+	if err != nil {
+		return t, err
+	}
+	return v.Interface().(T), nil
+}
+
+// convertValue converts a value to the given type. Numbers convert to other
+// numbers, and slices convert element by element.
+func convertValue(v reflect.Value, t reflect.Type) (reflect.Value, error) {
+	if !v.IsValid() {
+		return reflect.Zero(t), nil
+	}
+	if v.Kind() == reflect.Interface {
+		return convertValue(v.Elem(), t)
+	}
+	if v.Type().AssignableTo(t) {
+		return v, nil
+	}
+	if t.Kind() == reflect.Slice && v.Kind() == reflect.Slice {
+		s := reflect.MakeSlice(t, v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			e, err := convertValue(v.Index(i), t.Elem())
+			if err != nil {
+				return reflect.Value{}, err
+			}
+			s.Index(i).Set(e)
+		}
+		return s, nil
+	}
+	if (isNumber(v.Kind()) && isNumber(t.Kind()) || v.Kind() == t.Kind()) && v.Type().ConvertibleTo(t) {
+		return v.Convert(t), nil
+	}
+	return reflect.Value{}, fmt.Errorf("can't use %T as %s", v.Interface(), t)
+}
+
+// isNumber reports whether a kind is a number.
+func isNumber(k reflect.Kind) bool {
+	return k >= reflect.Int && k <= reflect.Float64
+}